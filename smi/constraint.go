@@ -0,0 +1,177 @@
+package smi
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Range is an inclusive range of values used by subtype constraints, e.g. (1..255).
+// A range with equal minimum and maximum constrains to a single value.
+type Range struct {
+	Min int64
+	Max int64
+}
+
+// Contains returns true if the value lies within the range.
+func (r Range) Contains(v int64) bool {
+	return v >= r.Min && v <= r.Max
+}
+
+// String returns the ASN.1 representation of the range.
+func (r Range) String() string {
+	if r.Min == r.Max {
+		return strconv.FormatInt(r.Min, 10)
+	}
+
+	return strconv.FormatInt(r.Min, 10) + ".." + strconv.FormatInt(r.Max, 10)
+}
+
+// formatRanges returns the ASN.1 representation of a list of ranges, e.g. "1..10 | 20".
+func formatRanges(ranges []Range) string {
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = r.String()
+	}
+
+	return strings.Join(parts, " | ")
+}
+
+// checkRanges panics if any of the ranges is empty or lies outside the given bounds.
+func checkRanges(typ string, ranges []Range, lower, upper int64) {
+	if len(ranges) == 0 {
+		panic(fmt.Sprintf("%s: at least one range is required", typ))
+	}
+
+	for _, r := range ranges {
+		switch {
+		case r.Min > r.Max:
+			panic(fmt.Sprintf("%s: range %d..%d has minimum greater than maximum", typ, r.Min, r.Max))
+		case r.Min < lower || r.Max > upper:
+			panic(fmt.Sprintf("%s: range %v exceeds %d..%d", typ, r, lower, upper))
+		}
+	}
+}
+
+// IntegerRangeType is an Integer32 type constrained to one or more value ranges:
+//
+//	Integer32 (1..2147483647)
+type IntegerRangeType struct {
+	name        string
+	description string
+	ranges      []Range
+}
+
+// NewIntegerRangeType returns a new Integer32 type constrained to the given ranges.
+// Panics if no range is given or a range is empty or outside of the Integer32 value space.
+func NewIntegerRangeType(name, description string, ranges ...Range) *IntegerRangeType {
+	checkRanges("integer range type "+name, ranges, math.MinInt32, math.MaxInt32)
+
+	return &IntegerRangeType{
+		name:        name,
+		description: description,
+		ranges:      append([]Range(nil), ranges...),
+	}
+}
+
+// Name returns the name of the type.
+func (t *IntegerRangeType) Name() string {
+	return t.name
+}
+
+// Description returns the description of the type.
+func (t *IntegerRangeType) Description() string {
+	return t.description
+}
+
+// BaseType returns BaseTypeInteger32.
+func (t *IntegerRangeType) BaseType() BaseType {
+	return BaseTypeInteger32
+}
+
+// Units returns an empty string, as textual conventions have no units.
+func (t *IntegerRangeType) Units() string {
+	return ""
+}
+
+// Ranges returns the allowed value ranges of the type.
+func (t *IntegerRangeType) Ranges() []Range {
+	return append([]Range(nil), t.ranges...)
+}
+
+// ValidateValue returns an error if the value is not within any of the allowed ranges.
+func (t *IntegerRangeType) ValidateValue(v int64) error {
+	for _, r := range t.ranges {
+		if r.Contains(v) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("value %d is not within %s", v, formatRanges(t.ranges))
+}
+
+func (t *IntegerRangeType) String() string {
+	return t.name
+}
+
+// OctetStringSizeType is an OCTET STRING type constrained to one or more size ranges:
+//
+//	OCTET STRING (SIZE (0..255))
+type OctetStringSizeType struct {
+	name        string
+	description string
+	sizes       []Range
+}
+
+// NewOctetStringSizeType returns a new OCTET STRING type constrained to the given sizes.
+// Panics if no size is given or a size range is empty or outside of 0..65535.
+func NewOctetStringSizeType(name, description string, sizes ...Range) *OctetStringSizeType {
+	checkRanges("octet string size type "+name, sizes, 0, math.MaxUint16)
+
+	return &OctetStringSizeType{
+		name:        name,
+		description: description,
+		sizes:       append([]Range(nil), sizes...),
+	}
+}
+
+// Name returns the name of the type.
+func (t *OctetStringSizeType) Name() string {
+	return t.name
+}
+
+// Description returns the description of the type.
+func (t *OctetStringSizeType) Description() string {
+	return t.description
+}
+
+// BaseType returns BaseTypeOctetString.
+func (t *OctetStringSizeType) BaseType() BaseType {
+	return BaseTypeOctetString
+}
+
+// Units returns an empty string, as textual conventions have no units.
+func (t *OctetStringSizeType) Units() string {
+	return ""
+}
+
+// Sizes returns the allowed size ranges of the type.
+func (t *OctetStringSizeType) Sizes() []Range {
+	return append([]Range(nil), t.sizes...)
+}
+
+// ValidateValue returns an error if the length of the value is not within any of the allowed sizes.
+func (t *OctetStringSizeType) ValidateValue(b []byte) error {
+	for _, r := range t.sizes {
+		if r.Contains(int64(len(b))) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("size %d is not within %s", len(b), formatRanges(t.sizes))
+}
+
+func (t *OctetStringSizeType) String() string {
+	return t.name
+}
@@ -0,0 +1,126 @@
+package smi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRange_String(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		want string
+	}{
+		{"Range", Range{1, 10}, "1..10"},
+		{"Single value", Range{5, 5}, "5"},
+		{"Negative range", Range{-5, -1}, "-5..-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.String(); got != tt.want {
+				t.Errorf("Range.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewIntegerRangeType_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []Range
+	}{
+		{"No ranges", nil},
+		{"Minimum greater than maximum", []Range{{10, 1}}},
+		{"Exceeds Integer32", []Range{{0, 1 << 31}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewIntegerRangeType() did not panic")
+				}
+			}()
+
+			NewIntegerRangeType("Test", "", tt.ranges...)
+		})
+	}
+}
+
+func TestIntegerRangeType_ValidateValue(t *testing.T) {
+	typ := NewIntegerRangeType("Test", "", Range{1, 10}, Range{20, 20})
+
+	tests := []struct {
+		name    string
+		value   int64
+		wantErr error
+	}{
+		{"Lower bound", 1, nil},
+		{"Upper bound", 10, nil},
+		{"Single value", 20, nil},
+		{"Below range", 0, errors.New("value 0 is not within 1..10 | 20")},
+		{"Between ranges", 15, errors.New("value 15 is not within 1..10 | 20")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := typ.ValidateValue(tt.value)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("IntegerRangeType.ValidateValue() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Errorf("IntegerRangeType.ValidateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewOctetStringSizeType_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		sizes []Range
+	}{
+		{"No sizes", nil},
+		{"Negative size", []Range{{-1, 4}}},
+		{"Exceeds maximum size", []Range{{0, 65536}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewOctetStringSizeType() did not panic")
+				}
+			}()
+
+			NewOctetStringSizeType("Test", "", tt.sizes...)
+		})
+	}
+}
+
+func TestOctetStringSizeType_ValidateValue(t *testing.T) {
+	typ := NewOctetStringSizeType("PhysAddress", "", Range{0, 0}, Range{6, 6})
+
+	tests := []struct {
+		name    string
+		value   []byte
+		wantErr error
+	}{
+		{"Empty value", nil, nil},
+		{"Fixed size", make([]byte, 6), nil},
+		{"Wrong size", make([]byte, 4), errors.New("size 4 is not within 0 | 6")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := typ.ValidateValue(tt.value)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("OctetStringSizeType.ValidateValue() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Errorf("OctetStringSizeType.ValidateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package smi
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DisplayHint is a DISPLAY-HINT clause of a TEXTUAL-CONVENTION as defined in RFC 2579 section 3.1.
+// Depending on the base type of the textual convention, it is either an octet-format
+// (e.g. "1x:" for MAC addresses) or an integer-format (e.g. "d-1" for deci-units).
+type DisplayHint string
+
+// FormatInteger formats an integer value according to the display hint.
+// The hint must be an integer-format: "x", "o", "b" or "d", optionally followed by "-" and the
+// number of implied decimal places.
+func (h DisplayHint) FormatInteger(v int64) (string, error) {
+	if h == "" {
+		return "", errors.New("display hint cannot be empty")
+	}
+
+	var (
+		sign string
+		abs  = new(big.Int).SetInt64(v)
+	)
+	if v < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+
+	switch h[0] {
+	case 'x':
+		if len(h) > 1 {
+			return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+		}
+
+		return sign + abs.Text(16), nil
+	case 'o':
+		if len(h) > 1 {
+			return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+		}
+
+		return sign + abs.Text(8), nil
+	case 'b':
+		if len(h) > 1 {
+			return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+		}
+
+		return sign + abs.Text(2), nil
+	case 'd':
+		if len(h) == 1 {
+			return sign + abs.Text(10), nil
+		}
+
+		if h[1] != '-' {
+			return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+		}
+
+		places, err := strconv.Atoi(string(h[2:]))
+		if err != nil || places < 0 {
+			return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+		}
+
+		digits := abs.Text(10)
+		if places == 0 {
+			return sign + digits, nil
+		}
+
+		if len(digits) <= places {
+			digits = strings.Repeat("0", places-len(digits)+1) + digits
+		}
+
+		return sign + digits[:len(digits)-places] + "." + digits[len(digits)-places:], nil
+	default:
+		return "", fmt.Errorf("invalid integer display hint: %q", string(h))
+	}
+}
+
+// octetFormatSpec is a single octet-format specification of a display hint.
+type octetFormatSpec struct {
+	repeat     bool
+	length     int
+	format     byte
+	separator  byte
+	terminator byte
+}
+
+// parseOctetFormat parses the display hint as a list of octet-format specifications.
+func (h DisplayHint) parseOctetFormat() ([]octetFormatSpec, error) {
+	var (
+		specs []octetFormatSpec
+		s     = string(h)
+	)
+	for i := 0; i < len(s); {
+		var spec octetFormatSpec
+		if s[i] == '*' {
+			spec.repeat = true
+			i++
+		}
+
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			spec.length = spec.length*10 + int(s[i]-'0')
+			i++
+		}
+
+		if i == start {
+			return nil, fmt.Errorf("missing octet length at position %d", i+1)
+		}
+
+		if spec.length == 0 {
+			return nil, fmt.Errorf("octet length at position %d must be greater than zero", start+1)
+		}
+
+		if i == len(s) {
+			return nil, errors.New("missing display format")
+		}
+
+		switch s[i] {
+		case 'x', 'd', 'o', 'a', 't':
+			spec.format = s[i]
+			i++
+		default:
+			return nil, fmt.Errorf("invalid display format at position %d: %c", i+1, s[i])
+		}
+
+		if i < len(s) && !isOctetFormatStart(s[i]) {
+			spec.separator = s[i]
+			i++
+
+			if spec.repeat && i < len(s) && !isOctetFormatStart(s[i]) {
+				spec.terminator = s[i]
+				i++
+			}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+func isOctetFormatStart(c byte) bool {
+	return c == '*' || (c >= '0' && c <= '9')
+}
+
+// FormatOctetString formats an octet string according to the display hint.
+// The hint must be an octet-format; the last specification is applied repeatedly
+// until all octets are consumed, as described in RFC 2579.
+func (h DisplayHint) FormatOctetString(b []byte) (string, error) {
+	specs, err := h.parseOctetFormat()
+	if err != nil {
+		return "", err
+	}
+
+	if len(specs) == 0 {
+		return "", errors.New("display hint cannot be empty")
+	}
+
+	var (
+		sb  strings.Builder
+		pos int
+	)
+	for i := 0; pos < len(b); i++ {
+		spec := specs[min(i, len(specs)-1)]
+
+		count := 1
+		if spec.repeat {
+			count = int(b[pos])
+			pos++
+		}
+
+		for r := 0; r < count && pos < len(b); r++ {
+			n := min(spec.length, len(b)-pos)
+			chunk := b[pos : pos+n]
+			pos += n
+
+			switch spec.format {
+			case 'x':
+				// Hexadecimal output is zero-padded to two digits per octet, as net-snmp does.
+				sb.WriteString(hex.EncodeToString(chunk))
+			case 'd':
+				sb.WriteString(new(big.Int).SetBytes(chunk).Text(10))
+			case 'o':
+				sb.WriteString(new(big.Int).SetBytes(chunk).Text(8))
+			case 'a', 't':
+				sb.Write(chunk)
+			}
+
+			if pos >= len(b) {
+				break
+			}
+
+			switch {
+			case r == count-1 && spec.terminator != 0:
+				sb.WriteByte(spec.terminator)
+			case spec.separator != 0:
+				sb.WriteByte(spec.separator)
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,76 @@
+package smi
+
+import (
+	"testing"
+)
+
+func TestDisplayHint_FormatInteger(t *testing.T) {
+	tests := []struct {
+		name    string
+		hint    DisplayHint
+		value   int64
+		want    string
+		wantErr bool
+	}{
+		{"Decimal", "d", 1234, "1234", false},
+		{"Decimal with implied decimal point", "d-1", 1234, "123.4", false},
+		{"Decimal with leading zeros", "d-2", 5, "0.05", false},
+		{"Negative decimal with implied decimal point", "d-2", -1234, "-12.34", false},
+		{"Decimal with zero places", "d-0", 42, "42", false},
+		{"Hexadecimal", "x", 255, "ff", false},
+		{"Octal", "o", 8, "10", false},
+		{"Binary", "b", 5, "101", false},
+		{"Invalid - empty", "", 1, "", true},
+		{"Invalid - unknown format", "z", 1, "", true},
+		{"Invalid - bad decimal places", "d-a", 1, "", true},
+		{"Invalid - trailing characters", "x1", 1, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.hint.FormatInteger(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DisplayHint.FormatInteger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DisplayHint.FormatInteger() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplayHint_FormatOctetString(t *testing.T) {
+	tests := []struct {
+		name    string
+		hint    DisplayHint
+		value   []byte
+		want    string
+		wantErr bool
+	}{
+		{"MAC address", "1x:", []byte{0x00, 0x0c, 0x29, 0xa1, 0xb2, 0xc3}, "00:0c:29:a1:b2:c3", false},
+		{"IPv4 address", "1d.", []byte{192, 168, 1, 10}, "192.168.1.10", false},
+		{"DisplayString", "255a", []byte("eth0"), "eth0", false},
+		{"DateAndTime", "2d-1d-1d,1d:1d:1d.1d,1a1d:1d", []byte{0x07, 0xe2, 0x0a, 0x0e, 0x0d, 0x1e, 0x0f, 0x00, '+', 0x02, 0x00}, "2018-10-14,13:30:15.0,+2:0", false},
+		{"DateAndTime without timezone", "2d-1d-1d,1d:1d:1d.1d,1a1d:1d", []byte{0x07, 0xe2, 0x0a, 0x0e, 0x0d, 0x1e, 0x0f, 0x00}, "2018-10-14,13:30:15.0", false},
+		{"Multi-octet hexadecimal", "2x", []byte{0x01, 0x02}, "0102", false},
+		{"Repeat indicator with terminator", "*1d./1a", []byte{0x02, 10, 20, 'a', 'b'}, "10.20/ab", false},
+		{"Empty value", "1x:", []byte{}, "", false},
+		{"Invalid - empty", "", []byte{1}, "", true},
+		{"Invalid - missing length", "x", []byte{1}, "", true},
+		{"Invalid - zero length", "0x", []byte{1}, "", true},
+		{"Invalid - missing format", "1", []byte{1}, "", true},
+		{"Invalid - unknown format", "1z", []byte{1}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.hint.FormatOctetString(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DisplayHint.FormatOctetString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DisplayHint.FormatOctetString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package smi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// NamedNumber is a named value of an enumerated INTEGER type, e.g. up(1).
+type NamedNumber struct {
+	Label string
+	Value int64
+}
+
+// EnumType is an INTEGER type whose values are restricted to a set of named numbers:
+//
+//	INTEGER { up(1), down(2), testing(3) }
+type EnumType struct {
+	name        string
+	description string
+	values      []NamedNumber
+}
+
+// NewEnumType returns a new enumerated INTEGER type with the given named numbers.
+// Panics if a label or value is used more than once, as this indicates an invalid type definition.
+func NewEnumType(name, description string, values ...NamedNumber) *EnumType {
+	labels := make(map[string]struct{}, len(values))
+	numbers := make(map[int64]struct{}, len(values))
+	for _, v := range values {
+		if _, ok := labels[v.Label]; ok {
+			panic(fmt.Sprintf("enum type %s: duplicate label %q", name, v.Label))
+		}
+
+		if _, ok := numbers[v.Value]; ok {
+			panic(fmt.Sprintf("enum type %s: duplicate value %d", name, v.Value))
+		}
+
+		labels[v.Label] = struct{}{}
+		numbers[v.Value] = struct{}{}
+	}
+
+	return &EnumType{
+		name:        name,
+		description: description,
+		values:      append([]NamedNumber(nil), values...),
+	}
+}
+
+// Name returns the name of the type.
+func (e *EnumType) Name() string {
+	return e.name
+}
+
+// Description returns the description of the type.
+func (e *EnumType) Description() string {
+	return e.description
+}
+
+// BaseType returns BaseTypeInteger, as only the INTEGER type may be enumerated.
+func (e *EnumType) BaseType() BaseType {
+	return BaseTypeInteger
+}
+
+// Units returns an empty string, as enumerated types have no units.
+func (e *EnumType) Units() string {
+	return ""
+}
+
+// Values returns the named numbers of the type in their declared order.
+func (e *EnumType) Values() []NamedNumber {
+	return append([]NamedNumber(nil), e.values...)
+}
+
+// Label returns the label of the given value.
+// Returns false if the value is not one of the named numbers.
+func (e *EnumType) Label(v int64) (string, bool) {
+	for _, n := range e.values {
+		if n.Value == v {
+			return n.Label, true
+		}
+	}
+
+	return "", false
+}
+
+// Value returns the value of the given label.
+// Returns false if the label is not one of the named numbers.
+func (e *EnumType) Value(label string) (int64, bool) {
+	for _, n := range e.values {
+		if n.Label == label {
+			return n.Value, true
+		}
+	}
+
+	return 0, false
+}
+
+// ValidateValue returns an error if the value is not one of the named numbers.
+func (e *EnumType) ValidateValue(v int64) error {
+	if _, ok := e.Label(v); !ok {
+		return fmt.Errorf("value %d is not a named number of %s", v, e.name)
+	}
+
+	return nil
+}
+
+// Format returns the string representation of the given value, e.g. "up(1)".
+// Values which are not named numbers are formatted as a plain number.
+func (e *EnumType) Format(v int64) string {
+	label, ok := e.Label(v)
+	if !ok {
+		return strconv.FormatInt(v, 10)
+	}
+
+	return label + "(" + strconv.FormatInt(v, 10) + ")"
+}
+
+func (e *EnumType) String() string {
+	return e.name
+}
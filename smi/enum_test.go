@@ -0,0 +1,89 @@
+package smi
+
+import (
+	"testing"
+)
+
+var testIfOperStatus = NewEnumType("IfOperStatus", "The current operational state of the interface.",
+	NamedNumber{"up", 1},
+	NamedNumber{"down", 2},
+	NamedNumber{"testing", 3},
+)
+
+func TestNewEnumType_Duplicates(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []NamedNumber
+	}{
+		{"Duplicate label", []NamedNumber{{"up", 1}, {"up", 2}}},
+		{"Duplicate value", []NamedNumber{{"up", 1}, {"down", 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewEnumType() did not panic")
+				}
+			}()
+
+			NewEnumType("Test", "", tt.values...)
+		})
+	}
+}
+
+func TestEnumType_ValidateValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int64
+		wantErr bool
+	}{
+		{"Named value", 2, false},
+		{"Unnamed value", 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testIfOperStatus.ValidateValue(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("EnumType.ValidateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnumType_Lookup(t *testing.T) {
+	if label, ok := testIfOperStatus.Label(3); !ok || label != "testing" {
+		t.Errorf("EnumType.Label() = %v, %v, want testing, true", label, ok)
+	}
+
+	if _, ok := testIfOperStatus.Label(7); ok {
+		t.Errorf("EnumType.Label() found unnamed value")
+	}
+
+	if v, ok := testIfOperStatus.Value("down"); !ok || v != 2 {
+		t.Errorf("EnumType.Value() = %v, %v, want 2, true", v, ok)
+	}
+
+	if _, ok := testIfOperStatus.Value("dormant"); ok {
+		t.Errorf("EnumType.Value() found unknown label")
+	}
+}
+
+func TestEnumType_Format(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int64
+		want  string
+	}{
+		{"Named value", 1, "up(1)"},
+		{"Unnamed value", 9, "9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testIfOperStatus.Format(tt.value); got != tt.want {
+				t.Errorf("EnumType.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
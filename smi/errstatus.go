@@ -0,0 +1,108 @@
+package smi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ErrorStatus is the error-status field of a Response-PDU as defined in RFC 3416 section 3.
+// Values 1 through 5 are also used by SNMPv1; the others were introduced with SNMPv2.
+//
+// ErrorStatus implements error, so a status can be used as a sentinel with errors.Is:
+//
+//	if errors.Is(err, smi.ErrorStatusNoSuchName) { ... }
+type ErrorStatus int
+
+const (
+	ErrorStatusNoError ErrorStatus = iota
+	ErrorStatusTooBig
+	ErrorStatusNoSuchName
+	ErrorStatusBadValue
+	ErrorStatusReadOnly
+	ErrorStatusGenErr
+	ErrorStatusNoAccess
+	ErrorStatusWrongType
+	ErrorStatusWrongLength
+	ErrorStatusWrongEncoding
+	ErrorStatusWrongValue
+	ErrorStatusNoCreation
+	ErrorStatusInconsistentValue
+	ErrorStatusResourceUnavailable
+	ErrorStatusCommitFailed
+	ErrorStatusUndoFailed
+	ErrorStatusAuthorizationError
+	ErrorStatusNotWritable
+	ErrorStatusInconsistentName
+)
+
+// errorStatusNames holds the labels of the error-status values as used in RFC 3416.
+var errorStatusNames = [...]string{
+	ErrorStatusNoError:             "noError",
+	ErrorStatusTooBig:              "tooBig",
+	ErrorStatusNoSuchName:          "noSuchName",
+	ErrorStatusBadValue:            "badValue",
+	ErrorStatusReadOnly:            "readOnly",
+	ErrorStatusGenErr:              "genErr",
+	ErrorStatusNoAccess:            "noAccess",
+	ErrorStatusWrongType:           "wrongType",
+	ErrorStatusWrongLength:         "wrongLength",
+	ErrorStatusWrongEncoding:       "wrongEncoding",
+	ErrorStatusWrongValue:          "wrongValue",
+	ErrorStatusNoCreation:          "noCreation",
+	ErrorStatusInconsistentValue:   "inconsistentValue",
+	ErrorStatusResourceUnavailable: "resourceUnavailable",
+	ErrorStatusCommitFailed:        "commitFailed",
+	ErrorStatusUndoFailed:          "undoFailed",
+	ErrorStatusAuthorizationError:  "authorizationError",
+	ErrorStatusNotWritable:         "notWritable",
+	ErrorStatusInconsistentName:    "inconsistentName",
+}
+
+// IsValid returns true if the error-status is one of the values defined in RFC 3416.
+func (s ErrorStatus) IsValid() bool {
+	return s >= 0 && int(s) < len(errorStatusNames)
+}
+
+// String returns the label of the error-status, e.g. "noSuchName".
+// Unknown values are formatted as "errorStatus(42)".
+func (s ErrorStatus) String() string {
+	if !s.IsValid() {
+		return "errorStatus(" + strconv.Itoa(int(s)) + ")"
+	}
+
+	return errorStatusNames[s]
+}
+
+// Error returns the label of the error-status. Implements error.
+func (s ErrorStatus) Error() string {
+	return s.String()
+}
+
+// StatusError is an error reported by an SNMP entity in the error-status and error-index fields of a Response-PDU.
+// It unwraps to its Status, so both errors.Is with an ErrorStatus and errors.As with a *StatusError can be used.
+type StatusError struct {
+	// Status is the reported error-status.
+	Status ErrorStatus
+	// Index is the one-based error-index of the offending variable binding, or zero if no binding is at fault.
+	Index int
+	// OID is the name of the offending variable binding, or nil if no binding is at fault.
+	OID ObjectIdentifier
+}
+
+// Error returns a description of the error including the offending variable binding, if any.
+func (e *StatusError) Error() string {
+	if e.Index == 0 {
+		return e.Status.String()
+	}
+
+	if e.OID == nil {
+		return fmt.Sprintf("%s at error-index %d", e.Status, e.Index)
+	}
+
+	return fmt.Sprintf("%s at error-index %d (%s)", e.Status, e.Index, e.OID)
+}
+
+// Unwrap returns the error-status of the error.
+func (e *StatusError) Unwrap() error {
+	return e.Status
+}
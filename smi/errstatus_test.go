@@ -0,0 +1,70 @@
+package smi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorStatus_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		status ErrorStatus
+		want   string
+	}{
+		{"No error", ErrorStatusNoError, "noError"},
+		{"SNMPv1 status", ErrorStatusNoSuchName, "noSuchName"},
+		{"Last defined status", ErrorStatusInconsistentName, "inconsistentName"},
+		{"Unknown status", ErrorStatus(19), "errorStatus(19)"},
+		{"Negative status", ErrorStatus(-1), "errorStatus(-1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.String(); got != tt.want {
+				t.Errorf("ErrorStatus.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *StatusError
+		want string
+	}{
+		{"Without binding", &StatusError{Status: ErrorStatusTooBig}, "tooBig"},
+		{"With index", &StatusError{Status: ErrorStatusGenErr, Index: 2}, "genErr at error-index 2"},
+		{"With binding", &StatusError{Status: ErrorStatusNotWritable, Index: 1, OID: ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}}, "notWritable at error-index 1 (1.3.6.1.2.1.1.5.0)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("StatusError.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusError_IsAs(t *testing.T) {
+	var err error = fmt.Errorf("set sysName.0: %w", &StatusError{Status: ErrorStatusNotWritable, Index: 1, OID: ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}})
+
+	if !errors.Is(err, ErrorStatusNotWritable) {
+		t.Errorf("errors.Is(err, ErrorStatusNotWritable) = false, want true")
+	}
+
+	if errors.Is(err, ErrorStatusNoAccess) {
+		t.Errorf("errors.Is(err, ErrorStatusNoAccess) = true, want false")
+	}
+
+	var se *StatusError
+	if !errors.As(err, &se) {
+		t.Fatalf("errors.As(err, *StatusError) = false, want true")
+	}
+
+	if se.Index != 1 || !se.OID.Equals(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}) {
+		t.Errorf("errors.As() = %+v, want index 1 and sysName.0", se)
+	}
+}
@@ -0,0 +1,481 @@
+package smi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	oidType  = reflect.TypeFor[ObjectIdentifier]()
+	ipType   = reflect.TypeFor[net.IP]()
+	addrType = reflect.TypeFor[netip.Addr]()
+)
+
+var errIndexLength = errors.New("not enough sub-identifiers")
+
+// indexOptions are the options of a single index component, taken from the `snmp` struct tag.
+type indexOptions struct {
+	// size is the fixed size of a string component. Zero for variable-length strings.
+	size int
+	// implied is set for IMPLIED variable-length strings and OIDs, which are encoded without a length prefix.
+	implied bool
+}
+
+// parseIndexTag parses the `snmp` struct tag of an index field.
+// Returns false if the field is to be skipped.
+func parseIndexTag(tag string) (indexOptions, bool, error) {
+	var opts indexOptions
+	if tag == "-" {
+		return opts, false, nil
+	}
+
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(opt, "=")
+		switch key {
+		case "":
+		case "size":
+			size, err := strconv.Atoi(value)
+			if err != nil || size <= 0 {
+				return opts, false, fmt.Errorf("invalid size: %q", value)
+			}
+
+			opts.size = size
+		case "implied":
+			opts.implied = true
+		default:
+			return opts, false, fmt.Errorf("unknown option: %q", opt)
+		}
+	}
+
+	if opts.implied && opts.size > 0 {
+		return opts, false, errors.New("fixed-length strings cannot be implied")
+	}
+
+	return opts, true, nil
+}
+
+// indexField is a single component of a composite index.
+type indexField struct {
+	name  string
+	index int
+	opts  indexOptions
+}
+
+// indexFields returns the index components of a struct type in declaration order.
+func indexFields(t reflect.Type) ([]indexField, error) {
+	var fields []indexField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		opts, ok, err := parseIndexTag(f.Tag.Get("snmp"))
+		if err != nil {
+			return nil, fmt.Errorf("index field %s: %w", f.Name, err)
+		}
+
+		if ok {
+			fields = append(fields, indexField{name: f.Name, index: i, opts: opts})
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("index type %s has no exported fields", t)
+	}
+
+	for _, f := range fields[:len(fields)-1] {
+		if f.opts.implied {
+			return nil, fmt.Errorf("index field %s: only the last component can be implied", f.name)
+		}
+	}
+
+	for _, f := range fields {
+		if ft := t.Field(f.index).Type; f.opts.size > 0 && !isOctetStringIndex(ft) {
+			return nil, fmt.Errorf("index field %s: only strings and byte slices can have a fixed size", f.name)
+		}
+	}
+
+	if f := fields[len(fields)-1]; f.opts.implied && !isVariableLengthIndex(t.Field(f.index).Type) {
+		return nil, fmt.Errorf("index field %s: only strings and object identifiers can be implied", f.name)
+	}
+
+	return fields, nil
+}
+
+// isVariableLengthIndex returns true if the type is encoded as a variable-length index component.
+func isVariableLengthIndex(t reflect.Type) bool {
+	return t == oidType || isOctetStringIndex(t)
+}
+
+// isOctetStringIndex returns true if the type is encoded as an OCTET STRING index component.
+func isOctetStringIndex(t reflect.Type) bool {
+	return t.Kind() == reflect.String ||
+		(t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && t != ipType)
+}
+
+// isCompositeIndex returns true if the type is a struct made up of several index components.
+func isCompositeIndex(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != addrType
+}
+
+// EncodeIndex encodes a table index into the OID suffix identifying a conceptual row,
+// following the rules of RFC 2578 section 7.7.
+//
+// The index is either a single component or a struct whose exported fields are the
+// components in order. Supported component types are signed and unsigned integers
+// (a single sub-identifier), strings and byte slices (length-prefixed octets),
+// ObjectIdentifier (length-prefixed sub-identifiers) and net.IP or netip.Addr for
+// IpAddress (four octets). Fixed-length strings are declared with a `snmp:"size=n"`
+// tag and are encoded without a length prefix; fields tagged `snmp:"-"` are skipped.
+// Unknown tag options and sizes on other component types are reported as errors.
+//
+// The last component may be tagged `snmp:"implied"` if it is a variable-length string
+// or object identifier declared with the IMPLIED keyword. It is then encoded without a
+// length prefix and decoding consumes all remaining sub-identifiers.
+func EncodeIndex(idx any) (ObjectIdentifier, error) {
+	v := reflect.ValueOf(idx)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, errors.New("index cannot be nil")
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil, errors.New("index cannot be nil")
+	}
+
+	if !isCompositeIndex(v.Type()) {
+		return appendIndexValue(nil, v, indexOptions{})
+	}
+
+	fields, err := indexFields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	var oid ObjectIdentifier
+	for _, f := range fields {
+		oid, err = appendIndexValue(oid, v.Field(f.index), f.opts)
+		if err != nil {
+			return nil, fmt.Errorf("index field %s: %w", f.name, err)
+		}
+	}
+
+	return oid, nil
+}
+
+// DecodeIndex decodes the OID suffix of a conceptual row into the index pointed to by idx.
+// See EncodeIndex for the supported index types. All sub-identifiers must be consumed.
+func DecodeIndex(oid ObjectIdentifier, idx any) error {
+	v := reflect.ValueOf(idx)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return errors.New("index must be a non-nil pointer")
+	}
+
+	v = v.Elem()
+	if !isCompositeIndex(v.Type()) {
+		rest, err := decodeIndexValue(oid, v, indexOptions{})
+		if err != nil {
+			return err
+		}
+
+		return checkIndexRest(rest)
+	}
+
+	fields, err := indexFields(v.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		oid, err = decodeIndexValue(oid, v.Field(f.index), f.opts)
+		if err != nil {
+			return fmt.Errorf("index field %s: %w", f.name, err)
+		}
+	}
+
+	return checkIndexRest(oid)
+}
+
+// checkIndexRest returns an error if sub-identifiers remain after decoding an index.
+func checkIndexRest(rest ObjectIdentifier) error {
+	if len(rest) > 0 {
+		return fmt.Errorf("%d trailing sub-identifiers", len(rest))
+	}
+
+	return nil
+}
+
+// appendIndexValue appends the encoding of a single index component to the OID.
+func appendIndexValue(oid ObjectIdentifier, v reflect.Value, opts indexOptions) (ObjectIdentifier, error) {
+	switch t := v.Type(); {
+	case t == oidType:
+		return AppendIndexOID(oid, v.Interface().(ObjectIdentifier), opts.implied), nil
+	case t == ipType:
+		return AppendIndexIPAddress(oid, v.Interface().(net.IP))
+	case t == addrType:
+		addr := v.Interface().(netip.Addr)
+		if !addr.Is4() {
+			return nil, fmt.Errorf("not an IPv4 address: %v", addr)
+		}
+
+		return AppendIndexIPAddress(oid, addr.AsSlice())
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return nil, fmt.Errorf("integer is negative: %d", v.Int())
+		}
+
+		if v.Int() > math.MaxUint32 {
+			return nil, fmt.Errorf("integer is too large: %d", v.Int())
+		}
+
+		return AppendIndexInteger(oid, uint32(v.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxUint32 {
+			return nil, fmt.Errorf("integer is too large: %d", v.Uint())
+		}
+
+		return AppendIndexInteger(oid, uint32(v.Uint())), nil
+	case reflect.String:
+		return appendIndexOctets(oid, []byte(v.String()), opts)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return appendIndexOctets(oid, v.Bytes(), opts)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported index type %s", v.Type())
+}
+
+// appendIndexOctets appends a string-valued index component according to the field options.
+func appendIndexOctets(oid ObjectIdentifier, b []byte, opts indexOptions) (ObjectIdentifier, error) {
+	if opts.size == 0 {
+		return AppendIndexString(oid, b, opts.implied), nil
+	}
+
+	if len(b) != opts.size {
+		return nil, fmt.Errorf("fixed-length string must have %d octets, got %d", opts.size, len(b))
+	}
+
+	return AppendIndexFixedString(oid, b), nil
+}
+
+// decodeIndexValue decodes a single index component from the OID into v and returns the remaining sub-identifiers.
+func decodeIndexValue(oid ObjectIdentifier, v reflect.Value, opts indexOptions) (ObjectIdentifier, error) {
+	switch t := v.Type(); {
+	case t == oidType:
+		o, rest, err := DecodeIndexOID(oid, opts.implied)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Set(reflect.ValueOf(o))
+		return rest, nil
+	case t == ipType:
+		ip, rest, err := DecodeIndexIPAddress(oid)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Set(reflect.ValueOf(ip))
+		return rest, nil
+	case t == addrType:
+		ip, rest, err := DecodeIndexIPAddress(oid)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, _ := netip.AddrFromSlice(ip)
+		v.Set(reflect.ValueOf(addr))
+		return rest, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, rest, err := DecodeIndexInteger(oid)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.OverflowInt(int64(i)) {
+			return nil, fmt.Errorf("integer %d overflows %s", i, v.Type())
+		}
+
+		v.SetInt(int64(i))
+		return rest, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, rest, err := DecodeIndexInteger(oid)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.OverflowUint(uint64(i)) {
+			return nil, fmt.Errorf("integer %d overflows %s", i, v.Type())
+		}
+
+		v.SetUint(uint64(i))
+		return rest, nil
+	case reflect.String:
+		b, rest, err := decodeIndexOctets(oid, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		v.SetString(string(b))
+		return rest, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, rest, err := decodeIndexOctets(oid, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			v.SetBytes(b)
+			return rest, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported index type %s", v.Type())
+}
+
+// decodeIndexOctets decodes a string-valued index component according to the field options.
+func decodeIndexOctets(oid ObjectIdentifier, opts indexOptions) ([]byte, ObjectIdentifier, error) {
+	if opts.size == 0 {
+		return DecodeIndexString(oid, opts.implied)
+	}
+
+	return DecodeIndexFixedString(oid, opts.size)
+}
+
+// The functions below encode and decode single index components as described in RFC 2578 section 7.7.
+// They can be used to build or parse instance OIDs by hand, e.g. when the index layout is only known at runtime.
+// Like the built-in append, the Append functions may reuse the capacity of the given OID.
+// The Decode functions return the decoded component and the remaining sub-identifiers of the OID.
+
+// AppendIndexInteger appends an integer-valued index component as a single sub-identifier.
+func AppendIndexInteger(oid ObjectIdentifier, v uint32) ObjectIdentifier {
+	return append(oid, v)
+}
+
+// DecodeIndexInteger decodes an integer-valued index component.
+func DecodeIndexInteger(oid ObjectIdentifier) (uint32, ObjectIdentifier, error) {
+	if len(oid) < 1 {
+		return 0, nil, errIndexLength
+	}
+
+	return oid[0], oid[1:], nil
+}
+
+// AppendIndexString appends a variable-length string index component.
+// The octets are preceded by their length unless the component is declared IMPLIED.
+func AppendIndexString(oid ObjectIdentifier, b []byte, implied bool) ObjectIdentifier {
+	if !implied {
+		oid = append(oid, uint32(len(b)))
+	}
+
+	return AppendIndexFixedString(oid, b)
+}
+
+// DecodeIndexString decodes a variable-length string index component.
+// An IMPLIED string consumes all remaining sub-identifiers.
+func DecodeIndexString(oid ObjectIdentifier, implied bool) ([]byte, ObjectIdentifier, error) {
+	if implied {
+		return DecodeIndexFixedString(oid, len(oid))
+	}
+
+	if len(oid) < 1 {
+		return nil, nil, errIndexLength
+	}
+
+	return DecodeIndexFixedString(oid[1:], int(oid[0]))
+}
+
+// AppendIndexFixedString appends a fixed-length string index component, one sub-identifier per octet.
+func AppendIndexFixedString(oid ObjectIdentifier, b []byte) ObjectIdentifier {
+	for _, c := range b {
+		oid = append(oid, uint32(c))
+	}
+
+	return oid
+}
+
+// DecodeIndexFixedString decodes a fixed-length string index component of the given size.
+func DecodeIndexFixedString(oid ObjectIdentifier, size int) ([]byte, ObjectIdentifier, error) {
+	if size < 0 || size > len(oid) {
+		return nil, nil, errIndexLength
+	}
+
+	b := make([]byte, size)
+	for i, v := range oid[:size] {
+		if v > math.MaxUint8 {
+			return nil, nil, fmt.Errorf("sub-identifier at position %d is not an octet: %d", i+1, v)
+		}
+
+		b[i] = byte(v)
+	}
+
+	return b, oid[size:], nil
+}
+
+// AppendIndexOID appends an object identifier index component.
+// The sub-identifiers are preceded by their number unless the component is declared IMPLIED.
+func AppendIndexOID(oid ObjectIdentifier, o ObjectIdentifier, implied bool) ObjectIdentifier {
+	if !implied {
+		oid = append(oid, uint32(len(o)))
+	}
+
+	return append(oid, o...)
+}
+
+// DecodeIndexOID decodes an object identifier index component.
+// An IMPLIED object identifier consumes all remaining sub-identifiers.
+// The returned object identifier does not share memory with oid.
+func DecodeIndexOID(oid ObjectIdentifier, implied bool) (ObjectIdentifier, ObjectIdentifier, error) {
+	if implied {
+		return oid.clone(), nil, nil
+	}
+
+	if len(oid) < 1 {
+		return nil, nil, errIndexLength
+	}
+
+	n, oid := int(oid[0]), oid[1:]
+	if n > len(oid) {
+		return nil, nil, errIndexLength
+	}
+
+	return oid[:n].clone(), oid[n:], nil
+}
+
+// AppendIndexIPAddress appends an IpAddress index component as four sub-identifiers.
+// Returns an error if the address is not an IPv4 address.
+func AppendIndexIPAddress(oid ObjectIdentifier, ip net.IP) (ObjectIdentifier, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("not an IPv4 address: %v", ip)
+	}
+
+	return AppendIndexFixedString(oid, ip4), nil
+}
+
+// DecodeIndexIPAddress decodes an IpAddress index component.
+func DecodeIndexIPAddress(oid ObjectIdentifier) (net.IP, ObjectIdentifier, error) {
+	b, rest, err := DecodeIndexFixedString(oid, net.IPv4len)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return net.IP(b), rest, nil
+}
@@ -0,0 +1,211 @@
+package smi
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+type testInterfaceIndex struct {
+	IfIndex int
+	VlanID  uint16
+}
+
+type testAddressIndex struct {
+	Name    string
+	Address net.IP
+	Ignored int `snmp:"-"`
+}
+
+type testFixedIndex struct {
+	MAC   []byte `snmp:"size=6"`
+	Table ObjectIdentifier
+}
+
+type testNotifyFilterIndex struct {
+	ProfileName string
+	Subtree     ObjectIdentifier `snmp:"implied"`
+}
+
+type testImpliedStringIndex struct {
+	Name string `snmp:"implied"`
+}
+
+type testInvalidImpliedIndex struct {
+	Name    string `snmp:"implied"`
+	IfIndex int
+}
+
+type testInvalidImpliedTypeIndex struct {
+	IfIndex int `snmp:"implied"`
+}
+
+type testUnknownOptionIndex struct {
+	Name string `snmp:"implid"`
+}
+
+type testInvalidSizeTypeIndex struct {
+	B ObjectIdentifier `snmp:"size=3"`
+}
+
+type testAddrIndex struct {
+	Addr netip.Addr
+	Port uint
+}
+
+func TestEncodeIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		idx     any
+		want    ObjectIdentifier
+		wantErr bool
+	}{
+		{"Single integer", 3, ObjectIdentifier{3}, false},
+		{"Single string", "eth0", ObjectIdentifier{4, 'e', 't', 'h', '0'}, false},
+		{"Composite integers", testInterfaceIndex{IfIndex: 5, VlanID: 100}, ObjectIdentifier{5, 100}, false},
+		{"Pointer to composite", &testInterfaceIndex{IfIndex: 5, VlanID: 100}, ObjectIdentifier{5, 100}, false},
+		{"String and IpAddress", testAddressIndex{Name: "ab", Address: net.IPv4(10, 0, 0, 1), Ignored: 7}, ObjectIdentifier{2, 'a', 'b', 10, 0, 0, 1}, false},
+		{"Fixed string and OID", testFixedIndex{MAC: []byte{1, 2, 3, 4, 5, 6}, Table: ObjectIdentifier{1, 3, 6}}, ObjectIdentifier{1, 2, 3, 4, 5, 6, 3, 1, 3, 6}, false},
+		{"netip.Addr", testAddrIndex{Addr: netip.MustParseAddr("192.168.0.1"), Port: 161}, ObjectIdentifier{192, 168, 0, 1, 161}, false},
+		{"Implied OID", testNotifyFilterIndex{ProfileName: "p", Subtree: ObjectIdentifier{1, 3, 6}}, ObjectIdentifier{1, 'p', 1, 3, 6}, false},
+		{"Implied string", testImpliedStringIndex{Name: "ab"}, ObjectIdentifier{'a', 'b'}, false},
+		{"Invalid - implied component not last", testInvalidImpliedIndex{Name: "ab", IfIndex: 1}, nil, true},
+		{"Invalid - implied integer", testInvalidImpliedTypeIndex{IfIndex: 1}, nil, true},
+		{"Invalid - unknown tag option", testUnknownOptionIndex{Name: "ab"}, nil, true},
+		{"Invalid - fixed size OID", testInvalidSizeTypeIndex{B: ObjectIdentifier{1, 3, 6}}, nil, true},
+		{"Invalid - negative integer", -1, nil, true},
+		{"Invalid - integer too large", uint64(1 << 32), nil, true},
+		{"Invalid - wrong fixed size", testFixedIndex{MAC: []byte{1, 2, 3}}, nil, true},
+		{"Invalid - IPv6 address", testAddrIndex{Addr: netip.MustParseAddr("::1")}, nil, true},
+		{"Invalid - unsupported type", 1.5, nil, true},
+		{"Invalid - nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeIndex(tt.idx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EncodeIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		oid     ObjectIdentifier
+		idx     any
+		want    any
+		wantErr bool
+	}{
+		{"Single integer", ObjectIdentifier{3}, new(int), 3, false},
+		{"Single string", ObjectIdentifier{2, 'h', 'i'}, new(string), "hi", false},
+		{"Composite integers", ObjectIdentifier{5, 100}, &testInterfaceIndex{}, testInterfaceIndex{IfIndex: 5, VlanID: 100}, false},
+		{"String and IpAddress", ObjectIdentifier{2, 'a', 'b', 10, 0, 0, 1}, &testAddressIndex{}, testAddressIndex{Name: "ab", Address: net.IP{10, 0, 0, 1}}, false},
+		{"Fixed string and OID", ObjectIdentifier{1, 2, 3, 4, 5, 6, 3, 1, 3, 6}, &testFixedIndex{}, testFixedIndex{MAC: []byte{1, 2, 3, 4, 5, 6}, Table: ObjectIdentifier{1, 3, 6}}, false},
+		{"netip.Addr", ObjectIdentifier{192, 168, 0, 1, 161}, &testAddrIndex{}, testAddrIndex{Addr: netip.MustParseAddr("192.168.0.1"), Port: 161}, false},
+		{"Implied OID", ObjectIdentifier{1, 'p', 1, 3, 6}, &testNotifyFilterIndex{}, testNotifyFilterIndex{ProfileName: "p", Subtree: ObjectIdentifier{1, 3, 6}}, false},
+		{"Implied string", ObjectIdentifier{'a', 'b'}, &testImpliedStringIndex{}, testImpliedStringIndex{Name: "ab"}, false},
+		{"Invalid - implied component not last", ObjectIdentifier{'a', 1}, &testInvalidImpliedIndex{}, nil, true},
+		{"Invalid - unknown tag option", ObjectIdentifier{'a'}, &testUnknownOptionIndex{}, nil, true},
+		{"Invalid - fixed size OID", ObjectIdentifier{1, 3, 6}, &testInvalidSizeTypeIndex{}, nil, true},
+		{"Invalid - trailing sub-identifiers", ObjectIdentifier{5, 100, 1}, &testInterfaceIndex{}, nil, true},
+		{"Invalid - truncated", ObjectIdentifier{5}, &testInterfaceIndex{}, nil, true},
+		{"Invalid - string length exceeds OID", ObjectIdentifier{5, 'a'}, new(string), nil, true},
+		{"Invalid - sub-identifier is not an octet", ObjectIdentifier{1, 256}, new(string), nil, true},
+		{"Invalid - integer overflows field", ObjectIdentifier{5, 70000}, &testInterfaceIndex{}, nil, true},
+		{"Invalid - not a pointer", ObjectIdentifier{1}, 0, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeIndex(tt.oid, tt.idx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := reflect.ValueOf(tt.idx).Elem().Interface(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexComponents(t *testing.T) {
+	oid := ObjectIdentifier{1, 3, 6, 1, 2, 1, 4, 22, 1, 2}
+	oid = AppendIndexInteger(oid, 3)
+	oid, err := AppendIndexIPAddress(oid, net.IPv4(10, 0, 0, 1))
+	if err != nil {
+		t.Fatalf("AppendIndexIPAddress() unexpected error = %v", err)
+	}
+	oid = AppendIndexString(oid, []byte("ab"), false)
+	oid = AppendIndexFixedString(oid, []byte{0xde, 0xad})
+	oid = AppendIndexOID(oid, ObjectIdentifier{1, 3}, false)
+	oid = AppendIndexOID(oid, ObjectIdentifier{9, 9}, true)
+
+	want := ObjectIdentifier{1, 3, 6, 1, 2, 1, 4, 22, 1, 2, 3, 10, 0, 0, 1, 2, 'a', 'b', 0xde, 0xad, 2, 1, 3, 9, 9}
+	if !reflect.DeepEqual(oid, want) {
+		t.Fatalf("encoded index = %v, want %v", oid, want)
+	}
+
+	rest := oid[10:]
+	i, rest, err := DecodeIndexInteger(rest)
+	if err != nil || i != 3 {
+		t.Fatalf("DecodeIndexInteger() = %v, %v, want 3", i, err)
+	}
+
+	ip, rest, err := DecodeIndexIPAddress(rest)
+	if err != nil || !ip.Equal(net.IPv4(10, 0, 0, 1)) {
+		t.Fatalf("DecodeIndexIPAddress() = %v, %v, want 10.0.0.1", ip, err)
+	}
+
+	s, rest, err := DecodeIndexString(rest, false)
+	if err != nil || string(s) != "ab" {
+		t.Fatalf("DecodeIndexString() = %q, %v, want ab", s, err)
+	}
+
+	fixed, rest, err := DecodeIndexFixedString(rest, 2)
+	if err != nil || !reflect.DeepEqual(fixed, []byte{0xde, 0xad}) {
+		t.Fatalf("DecodeIndexFixedString() = %x, %v, want dead", fixed, err)
+	}
+
+	o, rest, err := DecodeIndexOID(rest, false)
+	if err != nil || !o.Equals(ObjectIdentifier{1, 3}) {
+		t.Fatalf("DecodeIndexOID() = %v, %v, want 1.3", o, err)
+	}
+
+	implied, rest, err := DecodeIndexOID(rest, true)
+	if err != nil || !implied.Equals(ObjectIdentifier{9, 9}) || len(rest) != 0 {
+		t.Fatalf("DecodeIndexOID() implied = %v, %v, rest %v, want 9.9", implied, err, rest)
+	}
+}
+
+func TestIndexComponents_Invalid(t *testing.T) {
+	if _, _, err := DecodeIndexInteger(nil); err == nil {
+		t.Errorf("DecodeIndexInteger() on empty OID did not fail")
+	}
+
+	if _, _, err := DecodeIndexString(ObjectIdentifier{3, 'a'}, false); err == nil {
+		t.Errorf("DecodeIndexString() with too large length did not fail")
+	}
+
+	if _, _, err := DecodeIndexFixedString(ObjectIdentifier{300}, 1); err == nil {
+		t.Errorf("DecodeIndexFixedString() with non-octet sub-identifier did not fail")
+	}
+
+	if _, _, err := DecodeIndexOID(ObjectIdentifier{2, 1}, false); err == nil {
+		t.Errorf("DecodeIndexOID() with too large length did not fail")
+	}
+
+	if _, err := AppendIndexIPAddress(nil, net.ParseIP("::1")); err == nil {
+		t.Errorf("AppendIndexIPAddress() with IPv6 address did not fail")
+	}
+}
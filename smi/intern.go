@@ -0,0 +1,76 @@
+package smi
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// InternPool deduplicates object identifiers, so that equal OIDs seen over and over again
+// (e.g. column prefixes during walks or snmpTrapOID values in a trap receiver) share a single allocation.
+// The zero value is an empty pool ready to use. An InternPool is safe for concurrent use.
+//
+// OIDs returned by the pool are shared between all callers and must not be modified.
+// As the pool never evicts entries on its own, it should only be used for OIDs from a bounded set,
+// or be cleared periodically using Reset.
+type InternPool struct {
+	mu   sync.RWMutex
+	oids map[string]ObjectIdentifier
+}
+
+// Intern returns the canonical instance of the object identifier.
+// If the pool does not yet contain an equal object identifier, a copy of oid is added and returned.
+// Lookups of object identifiers with up to MaxSubIdentifiers sub-identifiers which are already in the pool do not allocate.
+func (p *InternPool) Intern(oid ObjectIdentifier) ObjectIdentifier {
+	// The key holds four bytes per sub-identifier; a buffer for the longest valid OID keeps it on the stack.
+	var arr [4 * MaxSubIdentifiers]byte
+	key := internKey(arr[:0], oid)
+
+	p.mu.RLock()
+	interned, ok := p.oids[string(key)]
+	p.mu.RUnlock()
+	if ok {
+		return interned
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if interned, ok := p.oids[string(key)]; ok {
+		return interned
+	}
+
+	if p.oids == nil {
+		p.oids = make(map[string]ObjectIdentifier)
+	}
+
+	interned = oid.clone()
+	p.oids[string(key)] = interned
+
+	return interned
+}
+
+// Len returns the number of object identifiers in the pool.
+func (p *InternPool) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.oids)
+}
+
+// Reset removes all object identifiers from the pool.
+// Previously returned object identifiers remain valid.
+func (p *InternPool) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.oids = nil
+}
+
+// internKey appends the map key of the object identifier to b.
+func internKey(b []byte, oid ObjectIdentifier) []byte {
+	for _, v := range oid {
+		b = binary.LittleEndian.AppendUint32(b, v)
+	}
+
+	return b
+}
@@ -0,0 +1,90 @@
+package smi
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestInternPool_Intern(t *testing.T) {
+	var pool InternPool
+
+	oid := ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2}
+	a := pool.Intern(oid)
+	b := pool.Intern(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2})
+	c := pool.Intern(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 3})
+
+	if !a.Equals(oid) {
+		t.Errorf("InternPool.Intern() = %v, want %v", a, oid)
+	}
+
+	if &a[0] != &b[0] {
+		t.Errorf("InternPool.Intern() returned different instances for equal OIDs")
+	}
+
+	if &a[0] == &c[0] || !c.Equals(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 3}) {
+		t.Errorf("InternPool.Intern() = %v, want distinct instance of 1.3.6.1.2.1.2.2.1.3", c)
+	}
+
+	if &a[0] == &oid[0] {
+		t.Errorf("InternPool.Intern() did not copy the OID")
+	}
+
+	oid[9] = 99
+	if got := pool.Intern(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2}); !got.Equals(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2}) {
+		t.Errorf("InternPool.Intern() affected by modification of input: %v", got)
+	}
+
+	if got := pool.Len(); got != 2 {
+		t.Errorf("InternPool.Len() = %v, want 2", got)
+	}
+
+	pool.Reset()
+	if got := pool.Len(); got != 0 {
+		t.Errorf("InternPool.Len() after reset = %v, want 0", got)
+	}
+}
+
+func TestInternPool_Concurrent(t *testing.T) {
+	var (
+		pool    InternPool
+		wg      sync.WaitGroup
+		results = make([]ObjectIdentifier, 16)
+	)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = pool.Intern(ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 4, 1, 0})
+		}()
+	}
+
+	wg.Wait()
+
+	for _, r := range results[1:] {
+		if &r[0] != &results[0][0] {
+			t.Fatalf("InternPool.Intern() returned different instances for concurrent callers")
+		}
+	}
+}
+
+func TestInternPool_InternDoesNotAllocate(t *testing.T) {
+	tests := []struct {
+		name string
+		oid  ObjectIdentifier
+	}{
+		{"Column prefix", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2}},
+		{"String-indexed instance", ObjectIdentifier{1, 3, 6, 1, 6, 3, 16, 1, 2, 1, 3, 2, 18, 't', 'r', 'a', 'p', '-', 'r', 'e', 'c', 'e', 'i', 'v', 'e', 'r'}},
+		{"Maximum length", append(ObjectIdentifier{1, 3}, make(ObjectIdentifier, MaxSubIdentifiers-2)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pool InternPool
+			pool.Intern(tt.oid)
+
+			if allocs := testing.AllocsPerRun(100, func() { pool.Intern(tt.oid) }); allocs != 0 {
+				t.Errorf("InternPool.Intern() of interned OID allocated %v times, want 0", allocs)
+			}
+		})
+	}
+}
@@ -1,15 +1,22 @@
 package smi
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 // ObjectIdentifier is an ASN.1 object identifier.
-type ObjectIdentifier []int
+// Sub-identifiers are stored as uint32, which covers the full range permitted by the SMI
+// at half the memory of an int on 64-bit platforms.
+type ObjectIdentifier []uint32
+
+// MaxSubIdentifiers is the maximum number of sub-identifiers of an object identifier, as defined in RFC 2578 section 7.1.3.
+const MaxSubIdentifiers = 128
 
 // ParseObjectIdentifier parses a string representation of an object identifier.
 func ParseObjectIdentifier(s string) (ObjectIdentifier, error) {
@@ -17,9 +24,23 @@ func ParseObjectIdentifier(s string) (ObjectIdentifier, error) {
 		return nil, nil
 	}
 
+	oid, err := parseSubIdentifiers(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := oid.Validate(); err != nil {
+		return nil, err
+	}
+
+	return oid, nil
+}
+
+// parseSubIdentifiers parses a dot-separated list of sub-identifiers without validating the result as a whole.
+func parseSubIdentifiers(s string) (ObjectIdentifier, error) {
 	var (
 		oid ObjectIdentifier
-		sid int
+		sid uint64
 	)
 	for i, c := range s {
 		switch {
@@ -33,18 +54,32 @@ func ParseObjectIdentifier(s string) (ObjectIdentifier, error) {
 			}
 
 			if i > 0 {
-				oid = append(oid, sid)
+				oid = append(oid, uint32(sid))
 				sid = 0
 			}
 		case c >= '0' && c <= '9':
-			sid = sid*10 + int(c-'0')
+			sid = sid*10 + uint64(c-'0')
+			if sid > math.MaxUint32 {
+				return nil, fmt.Errorf("sub-identifier at position %d is too large", len(oid)+1)
+			}
 		default:
 			return nil, fmt.Errorf("invalid character at position %d: %c", i, c)
 		}
 	}
 
-	oid = append(oid, sid)
-	return oid, oid.Validate()
+	// Clip the result so that appending to it, e.g. to a shared well-known OID, always copies.
+	return slices.Clip(append(oid, uint32(sid))), nil
+}
+
+// MustParseObjectIdentifier is like ParseObjectIdentifier but panics if the string cannot be parsed.
+// It simplifies the initialization of package-level variables holding object identifiers.
+func MustParseObjectIdentifier(s string) ObjectIdentifier {
+	oid, err := ParseObjectIdentifier(s)
+	if err != nil {
+		panic(fmt.Sprintf("invalid object identifier %q: %v", s, err))
+	}
+
+	return oid
 }
 
 // Equals returns true if the object identifier is equal to the given object identifier.
@@ -90,6 +125,32 @@ func (oid ObjectIdentifier) IsAfter(o ObjectIdentifier) bool {
 	return o.IsBefore(oid)
 }
 
+// Compare compares the object identifier with the given object identifier in SNMP lexicographic order.
+// Sub-identifiers are compared one by one; if one object identifier is a prefix of the other, the shorter one comes first.
+// The result is -1 if oid comes before o, 0 if they are equal and +1 if oid comes after o.
+func (oid ObjectIdentifier) Compare(o ObjectIdentifier) int {
+	for i, v := range oid {
+		if i >= len(o) {
+			return 1
+		}
+
+		if v != o[i] {
+			return cmp.Compare(v, o[i])
+		}
+	}
+
+	if len(oid) < len(o) {
+		return -1
+	}
+
+	return 0
+}
+
+// SortObjectIdentifiers sorts the object identifiers in SNMP lexicographic order.
+func SortObjectIdentifiers(oids []ObjectIdentifier) {
+	slices.SortFunc(oids, ObjectIdentifier.Compare)
+}
+
 // IsPrefixOf returns true if the object identifier is a prefix of the given object identifier.
 func (oid ObjectIdentifier) IsPrefixOf(o ObjectIdentifier) bool {
 	if len(oid) > len(o) {
@@ -105,6 +166,74 @@ func (oid ObjectIdentifier) IsPrefixOf(o ObjectIdentifier) bool {
 	return true
 }
 
+// HasPrefix returns true if the object identifier starts with the given prefix.
+// This is the inverse of IsPrefixOf and is provided for convenience:
+//
+//	oid.HasPrefix(p) == p.IsPrefixOf(oid)
+func (oid ObjectIdentifier) HasPrefix(prefix ObjectIdentifier) bool {
+	return prefix.IsPrefixOf(oid)
+}
+
+// TrimPrefix returns the object identifier without the given prefix, e.g. the instance part of a column OID.
+// If the object identifier does not start with the prefix, it is returned unchanged.
+// The returned object identifier does not share memory with oid.
+func (oid ObjectIdentifier) TrimPrefix(prefix ObjectIdentifier) ObjectIdentifier {
+	if !prefix.IsPrefixOf(oid) {
+		return oid.clone()
+	}
+
+	return oid[len(prefix):].clone()
+}
+
+// CommonPrefix returns the longest object identifier that is a prefix of both object identifiers.
+// The returned object identifier does not share memory with oid.
+func (oid ObjectIdentifier) CommonPrefix(o ObjectIdentifier) ObjectIdentifier {
+	n := 0
+	for n < len(oid) && n < len(o) && oid[n] == o[n] {
+		n++
+	}
+
+	return oid[:n].clone()
+}
+
+// Append returns a new object identifier with the given sub-identifiers appended.
+// Unlike the built-in append, the returned object identifier never shares memory with oid.
+func (oid ObjectIdentifier) Append(subids ...uint32) ObjectIdentifier {
+	out := make(ObjectIdentifier, len(oid), len(oid)+len(subids))
+	copy(out, oid)
+
+	return append(out, subids...)
+}
+
+// Parent returns the object identifier without its last sub-identifier.
+// Returns nil if the object identifier is empty.
+func (oid ObjectIdentifier) Parent() ObjectIdentifier {
+	if len(oid) == 0 {
+		return nil
+	}
+
+	return oid[:len(oid)-1].clone()
+}
+
+// NextSibling returns the object identifier with its last sub-identifier incremented by one.
+// This is the first object identifier after the subtree rooted at oid.
+// Returns nil if the object identifier is empty or its last sub-identifier is already at the maximum value.
+func (oid ObjectIdentifier) NextSibling() ObjectIdentifier {
+	if len(oid) == 0 || oid[len(oid)-1] == math.MaxUint32 {
+		return nil
+	}
+
+	out := oid.clone()
+	out[len(out)-1]++
+
+	return out
+}
+
+// clone returns a copy of the object identifier.
+func (oid ObjectIdentifier) clone() ObjectIdentifier {
+	return append(make(ObjectIdentifier, 0, len(oid)), oid...)
+}
+
 // IsScalar returns true if the object identifier is of a scalar node.
 // A scalar node must have a zero sub-identifier at the end of the OID.
 func IsScalar(oid ObjectIdentifier) bool {
@@ -128,17 +257,111 @@ func (oid ObjectIdentifier) Validate() error {
 		return errors.New("must have at least two sub-identifiers")
 	}
 
-	for i, v := range oid {
+	if len(oid) > MaxSubIdentifiers {
+		return fmt.Errorf("must have at most %d sub-identifiers: %d", MaxSubIdentifiers, len(oid))
+	}
+
+	if oid[0] > 2 {
+		return fmt.Errorf("first sub-identifier must be 0, 1, or 2: %v", oid[0])
+	}
+
+	return nil
+}
+
+// MarshalBinary returns the BER encoding of the content octets of the object identifier, as defined in X.690 section 8.19.
+// The first two sub-identifiers are combined into one and all sub-identifiers are encoded in base 128.
+// Implements encoding.BinaryMarshaler.
+func (oid ObjectIdentifier) MarshalBinary() ([]byte, error) {
+	if err := oid.Validate(); err != nil {
+		return nil, err
+	}
+
+	if oid[0] < 2 && oid[1] > 39 {
+		return nil, fmt.Errorf("second sub-identifier must be less than 40 if first is %d: %v", oid[0], oid[1])
+	}
+
+	b := make([]byte, 0, len(oid)+4)
+	b = appendBase128(b, uint64(oid[0])*40+uint64(oid[1]))
+	for _, v := range oid[2:] {
+		b = appendBase128(b, uint64(v))
+	}
+
+	return b, nil
+}
+
+// appendBase128 appends the base-128 encoding of v, most significant group first.
+func appendBase128(b []byte, v uint64) []byte {
+	n := 1
+	for t := v >> 7; t > 0; t >>= 7 {
+		n++
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		c := byte(v>>(7*i)) & 0x7f
+		if i > 0 {
+			c |= 0x80
+		}
+
+		b = append(b, c)
+	}
+
+	return b
+}
+
+// UnmarshalBinary decodes the BER encoded content octets of an object identifier, as produced by MarshalBinary.
+// Encodings of more than MaxSubIdentifiers sub-identifiers are rejected.
+// Implements encoding.BinaryUnmarshaler.
+func (oid *ObjectIdentifier) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("cannot decode empty data")
+	}
+
+	out := make(ObjectIdentifier, 0, min(len(data)+1, MaxSubIdentifiers))
+	for i := 0; i < len(data); {
+		if data[i] == 0x80 {
+			return fmt.Errorf("non-minimal sub-identifier encoding at offset %d", i)
+		}
+
+		var v uint64
+		for {
+			if i >= len(data) {
+				return errors.New("truncated sub-identifier")
+			}
+
+			c := data[i]
+			i++
+
+			v = v<<7 | uint64(c&0x7f)
+			if v > math.MaxUint32+80 {
+				return fmt.Errorf("sub-identifier at offset %d is too large", i-1)
+			}
+
+			if c&0x80 == 0 {
+				break
+			}
+		}
+
 		switch {
-		case v < 0:
-			return fmt.Errorf("sub-identifier at position %d is negative: %v", i+1, v)
-		case v > math.MaxUint32:
-			return fmt.Errorf("sub-identifier at position %d is too large: %v", i+1, v)
-		case i == 0 && v > 2:
-			return fmt.Errorf("first sub-identifier must be 0, 1, or 2: %v", v)
+		case len(out) > 0:
+			if v > math.MaxUint32 {
+				return fmt.Errorf("sub-identifier at offset %d is too large", i-1)
+			}
+
+			out = append(out, uint32(v))
+		case v < 40:
+			out = append(out, 0, uint32(v))
+		case v < 80:
+			out = append(out, 1, uint32(v-40))
+		default:
+			out = append(out, 2, uint32(v-80))
+		}
+
+		if len(out) > MaxSubIdentifiers {
+			return fmt.Errorf("must have at most %d sub-identifiers", MaxSubIdentifiers)
 		}
 	}
 
+	*oid = out
 	return nil
 }
 
@@ -154,7 +377,7 @@ func (oid ObjectIdentifier) String() string {
 			sb.WriteByte('.')
 		}
 
-		sb.WriteString(strconv.Itoa(v))
+		sb.WriteString(strconv.FormatUint(uint64(v), 10))
 	}
 
 	return sb.String()
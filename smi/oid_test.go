@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -96,16 +97,19 @@ func TestObjectIdentifier_Validate(t *testing.T) {
 	}{
 		{"Valid OID", ObjectIdentifier{1, 2}, nil},
 		{"Invalid OID - less than two sub-identifiers", ObjectIdentifier{1}, errors.New("must have at least two sub-identifiers")},
-		{"Invalid OID - negative sub-identifier", ObjectIdentifier{-1, 2}, errors.New("sub-identifier at position 1 is negative: -1")},
 		{"Invalid OID - first sub-identifier greater than 2", ObjectIdentifier{3, 2}, errors.New("first sub-identifier must be 0, 1, or 2: 3")},
+		{"Valid OID - maximum number of sub-identifiers", append(ObjectIdentifier{1, 3}, make(ObjectIdentifier, MaxSubIdentifiers-2)...), nil},
+		{"Invalid OID - too many sub-identifiers", append(ObjectIdentifier{1, 3}, make(ObjectIdentifier, MaxSubIdentifiers-1)...), errors.New("must have at most 128 sub-identifiers: 129")},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.oid.Validate(); err != nil {
-				if tt.wantErr == nil || err.Error() != tt.wantErr.Error() {
-					t.Errorf("ObjectIdentifier.Validate() error = %v, wantErr %v", err, tt.wantErr)
-				}
+			err := tt.oid.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("ObjectIdentifier.Validate() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Errorf("ObjectIdentifier.Validate() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -161,6 +165,9 @@ func TestParseObjectIdentifier(t *testing.T) {
 		{"Invalid OID - consecutive periods", "1..3", nil, errors.New("cannot have consecutive periods")},
 		{"Invalid OID - ends with a period", "1.2.", nil, errors.New("cannot end with a period")},
 		{"Invalid OID - invalid character", "1.2a.3", nil, fmt.Errorf("invalid character at position 3: a")},
+		{"Valid OID - maximum sub-identifier", "1.3.4294967295", ObjectIdentifier{1, 3, 4294967295}, nil},
+		{"Invalid OID - sub-identifier too large", "1.3.4294967296", nil, errors.New("sub-identifier at position 3 is too large")},
+		{"Invalid OID - too many sub-identifiers", "1.3" + strings.Repeat(".1", 199), nil, errors.New("must have at most 128 sub-identifiers: 201")},
 		{"Valid OID - empty string", "", nil, nil},
 	}
 
@@ -179,3 +186,262 @@ func TestParseObjectIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectIdentifier_HasPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		oid    ObjectIdentifier
+		prefix ObjectIdentifier
+		want   bool
+	}{
+		{"OID has prefix", ObjectIdentifier{1, 2, 3}, ObjectIdentifier{1, 2}, true},
+		{"OID does not have prefix", ObjectIdentifier{1, 2, 3}, ObjectIdentifier{1, 3}, false},
+		{"Prefix longer than OID", ObjectIdentifier{1, 2}, ObjectIdentifier{1, 2, 3}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.oid.HasPrefix(tt.prefix); got != tt.want {
+				t.Errorf("ObjectIdentifier.HasPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifier_TrimPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		oid    ObjectIdentifier
+		prefix ObjectIdentifier
+		want   ObjectIdentifier
+	}{
+		{"Trim column prefix", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2}, ObjectIdentifier{10}},
+		{"Trim whole OID", ObjectIdentifier{1, 2}, ObjectIdentifier{1, 2}, ObjectIdentifier{}},
+		{"Not a prefix", ObjectIdentifier{1, 2, 3}, ObjectIdentifier{1, 3}, ObjectIdentifier{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.oid.TrimPrefix(tt.prefix); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ObjectIdentifier.TrimPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifier_CommonPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		oid1 ObjectIdentifier
+		oid2 ObjectIdentifier
+		want ObjectIdentifier
+	}{
+		{"Partial common prefix", ObjectIdentifier{1, 3, 6, 1}, ObjectIdentifier{1, 3, 7}, ObjectIdentifier{1, 3}},
+		{"One is prefix of other", ObjectIdentifier{1, 3}, ObjectIdentifier{1, 3, 6}, ObjectIdentifier{1, 3}},
+		{"No common prefix", ObjectIdentifier{1, 3}, ObjectIdentifier{2, 3}, ObjectIdentifier{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.oid1.CommonPrefix(tt.oid2); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ObjectIdentifier.CommonPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifier_Append(t *testing.T) {
+	base := make(ObjectIdentifier, 2, 8)
+	copy(base, ObjectIdentifier{1, 3})
+
+	a := base.Append(6)
+	b := base.Append(7, 1)
+
+	if want := (ObjectIdentifier{1, 3, 6}); !reflect.DeepEqual(a, want) {
+		t.Errorf("ObjectIdentifier.Append() = %v, want %v", a, want)
+	}
+
+	if want := (ObjectIdentifier{1, 3, 7, 1}); !reflect.DeepEqual(b, want) {
+		t.Errorf("ObjectIdentifier.Append() = %v, want %v", b, want)
+	}
+}
+
+func TestObjectIdentifier_Parent(t *testing.T) {
+	tests := []struct {
+		name string
+		oid  ObjectIdentifier
+		want ObjectIdentifier
+	}{
+		{"Parent of OID", ObjectIdentifier{1, 3, 6}, ObjectIdentifier{1, 3}},
+		{"Parent of empty OID", ObjectIdentifier{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.oid.Parent(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ObjectIdentifier.Parent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifier_NextSibling(t *testing.T) {
+	oid := ObjectIdentifier{1, 3, 6}
+	got := oid.NextSibling()
+
+	if want := (ObjectIdentifier{1, 3, 7}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ObjectIdentifier.NextSibling() = %v, want %v", got, want)
+	}
+
+	if want := (ObjectIdentifier{1, 3, 6}); !reflect.DeepEqual(oid, want) {
+		t.Errorf("ObjectIdentifier.NextSibling() modified receiver: %v", oid)
+	}
+
+	if got := (ObjectIdentifier{}).NextSibling(); got != nil {
+		t.Errorf("ObjectIdentifier.NextSibling() = %v, want nil", got)
+	}
+
+	if got := (ObjectIdentifier{1, 3, 4294967295}).NextSibling(); got != nil {
+		t.Errorf("ObjectIdentifier.NextSibling() = %v, want nil", got)
+	}
+}
+
+func TestObjectIdentifier_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		oid1 ObjectIdentifier
+		oid2 ObjectIdentifier
+		want int
+	}{
+		{"Equal", ObjectIdentifier{1, 3, 6}, ObjectIdentifier{1, 3, 6}, 0},
+		{"Smaller sub-identifier", ObjectIdentifier{1, 3, 6}, ObjectIdentifier{1, 3, 7}, -1},
+		{"Larger sub-identifier", ObjectIdentifier{1, 3, 10}, ObjectIdentifier{1, 3, 9}, 1},
+		{"Prefix comes first", ObjectIdentifier{1, 3}, ObjectIdentifier{1, 3, 6}, -1},
+		{"Longer comes after prefix", ObjectIdentifier{1, 3, 6}, ObjectIdentifier{1, 3}, 1},
+		{"Numeric rather than textual order", ObjectIdentifier{1, 3, 2}, ObjectIdentifier{1, 3, 10}, -1},
+		{"Both empty", ObjectIdentifier{}, ObjectIdentifier{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.oid1.Compare(tt.oid2); got != tt.want {
+				t.Errorf("ObjectIdentifier.Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortObjectIdentifiers(t *testing.T) {
+	oids := []ObjectIdentifier{
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 10, 1},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 9},
+	}
+	want := []ObjectIdentifier{
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 9},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10},
+		{1, 3, 6, 1, 2, 1, 2, 2, 1, 10, 1},
+	}
+
+	SortObjectIdentifiers(oids)
+	if !reflect.DeepEqual(oids, want) {
+		t.Errorf("SortObjectIdentifiers() = %v, want %v", oids, want)
+	}
+}
+
+func TestMustParseObjectIdentifier(t *testing.T) {
+	if got, want := MustParseObjectIdentifier("1.3.6.1"), (ObjectIdentifier{1, 3, 6, 1}); !reflect.DeepEqual(got, want) {
+		t.Errorf("MustParseObjectIdentifier() = %v, want %v", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseObjectIdentifier() did not panic")
+		}
+	}()
+
+	MustParseObjectIdentifier("1..3")
+}
+
+func TestWellKnown_AppendDoesNotAlias(t *testing.T) {
+	a := append(IfEntry, 2)
+	b := append(IfEntry, 3)
+
+	if a.Equals(b) {
+		t.Errorf("append(IfEntry, 2) = %v, append(IfEntry, 3) = %v, want distinct OIDs", a, b)
+	}
+}
+
+func TestObjectIdentifier_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name    string
+		oid     ObjectIdentifier
+		want    []byte
+		wantErr bool
+	}{
+		{"sysDescr.0", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, []byte{0x2b, 0x06, 0x01, 0x02, 0x01, 0x01, 0x01, 0x00}, false},
+		{"Multi-byte sub-identifiers", ObjectIdentifier{1, 3, 6, 1, 4, 1, 2636, 128}, []byte{0x2b, 0x06, 0x01, 0x04, 0x01, 0x94, 0x4c, 0x81, 0x00}, false},
+		{"Maximum sub-identifier", ObjectIdentifier{1, 3, 4294967295}, []byte{0x2b, 0x8f, 0xff, 0xff, 0xff, 0x7f}, false},
+		{"Large second sub-identifier under joint-iso-itu-t", ObjectIdentifier{2, 999, 3}, []byte{0x88, 0x37, 0x03}, false},
+		{"Invalid - too short", ObjectIdentifier{1}, nil, true},
+		{"Invalid - second sub-identifier too large", ObjectIdentifier{1, 40}, nil, true},
+		{"Invalid - too many sub-identifiers", append(ObjectIdentifier{1, 3}, make(ObjectIdentifier, MaxSubIdentifiers-1)...), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.oid.MarshalBinary()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ObjectIdentifier.MarshalBinary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ObjectIdentifier.MarshalBinary() = %x, want %x", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var decoded ObjectIdentifier
+			if err := decoded.UnmarshalBinary(got); err != nil {
+				t.Fatalf("ObjectIdentifier.UnmarshalBinary() unexpected error = %v", err)
+			}
+			if !decoded.Equals(tt.oid) {
+				t.Errorf("ObjectIdentifier.UnmarshalBinary() = %v, want %v", decoded, tt.oid)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifier_UnmarshalBinary(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    ObjectIdentifier
+		wantErr bool
+	}{
+		{"First arc 0", []byte{0x27}, ObjectIdentifier{0, 39}, false},
+		{"First arc 1", []byte{0x28}, ObjectIdentifier{1, 0}, false},
+		{"First arc 2", []byte{0x50}, ObjectIdentifier{2, 0}, false},
+		{"Invalid - empty", []byte{}, nil, true},
+		{"Invalid - truncated", []byte{0x2b, 0x86}, nil, true},
+		{"Invalid - non-minimal encoding", []byte{0x2b, 0x80, 0x01}, nil, true},
+		{"Maximum number of sub-identifiers", append([]byte{0x2b}, make([]byte, MaxSubIdentifiers-2)...), append(ObjectIdentifier{1, 3}, make(ObjectIdentifier, MaxSubIdentifiers-2)...), false},
+		{"Invalid - too many sub-identifiers", append([]byte{0x2b}, make([]byte, MaxSubIdentifiers-1)...), nil, true},
+		{"Invalid - sub-identifier too large", []byte{0x2b, 0x90, 0x80, 0x80, 0x80, 0x00}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ObjectIdentifier
+			err := got.UnmarshalBinary(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ObjectIdentifier.UnmarshalBinary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ObjectIdentifier.UnmarshalBinary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
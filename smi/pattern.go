@@ -0,0 +1,118 @@
+package smi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// patternAnyOne matches exactly one sub-identifier.
+	patternAnyOne = -1
+	// patternAnySubtree matches zero or more sub-identifiers.
+	patternAnySubtree = -2
+)
+
+// Pattern is an object identifier pattern which may contain wildcards.
+// A '*' matches exactly one sub-identifier, a '**' matches any number of sub-identifiers (including none):
+//
+//	1.3.6.1.2.1.2.2.1.*.10   matches column of ifEntry for interface 10
+//	1.3.6.1.2.1.2.2.1.2.**   matches all instances of ifDescr
+//
+// Patterns are created with ParsePattern; the zero value matches only the empty object identifier.
+type Pattern struct {
+	// arcs holds sub-identifiers and the wildcard sentinels. Consecutive subtree wildcards are merged.
+	arcs []int64
+}
+
+// ParsePattern parses a string representation of an object identifier pattern.
+func ParsePattern(s string) (Pattern, error) {
+	s = strings.TrimPrefix(s, ".")
+	if s == "" {
+		return Pattern{}, errors.New("cannot be empty")
+	}
+
+	parts := strings.Split(s, ".")
+	arcs := make([]int64, 0, len(parts))
+	for i, part := range parts {
+		switch part {
+		case "":
+			if i == len(parts)-1 {
+				return Pattern{}, errors.New("cannot end with a period")
+			}
+
+			return Pattern{}, errors.New("cannot have consecutive periods")
+		case "*":
+			arcs = append(arcs, patternAnyOne)
+		case "**":
+			// A run of subtree wildcards matches the same as a single one.
+			if len(arcs) == 0 || arcs[len(arcs)-1] != patternAnySubtree {
+				arcs = append(arcs, patternAnySubtree)
+			}
+		default:
+			v, err := strconv.ParseUint(part, 10, 32)
+			if err != nil {
+				return Pattern{}, fmt.Errorf("invalid sub-identifier at position %d: %q", i+1, part)
+			}
+
+			arcs = append(arcs, int64(v))
+		}
+	}
+
+	return Pattern{arcs: arcs}, nil
+}
+
+// Match returns true if the given object identifier matches the pattern.
+// Matching keeps a single backtrack point at the most recent subtree wildcard,
+// so it runs in at most O(len(pattern) * len(oid)) steps regardless of the number of wildcards.
+func (p Pattern) Match(oid ObjectIdentifier) bool {
+	var (
+		pi, oi int
+		star   = -1
+		mark   int
+	)
+	for oi < len(oid) {
+		switch {
+		case pi < len(p.arcs) && p.arcs[pi] == patternAnySubtree:
+			star, mark = pi, oi
+			pi++
+		case pi < len(p.arcs) && (p.arcs[pi] == patternAnyOne || p.arcs[pi] == int64(oid[oi])):
+			pi++
+			oi++
+		case star >= 0:
+			// Let the last subtree wildcard consume one more sub-identifier and retry.
+			mark++
+			pi, oi = star+1, mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p.arcs) && p.arcs[pi] == patternAnySubtree {
+		pi++
+	}
+
+	return pi == len(p.arcs)
+}
+
+// String returns the string representation of the pattern.
+func (p Pattern) String() string {
+	var sb strings.Builder
+	for i, v := range p.arcs {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+
+		switch v {
+		case patternAnyOne:
+			sb.WriteByte('*')
+		case patternAnySubtree:
+			sb.WriteString("**")
+		default:
+			sb.WriteString(strconv.FormatInt(v, 10))
+		}
+	}
+
+	return sb.String()
+}
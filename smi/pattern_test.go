@@ -0,0 +1,120 @@
+package smi
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Pattern
+		wantErr error
+	}{
+		{"Plain OID", "1.3.6", Pattern{arcs: []int64{1, 3, 6}}, nil},
+		{"Leading period", ".1.3.6", Pattern{arcs: []int64{1, 3, 6}}, nil},
+		{"Single-arc wildcard", "1.*.6", Pattern{arcs: []int64{1, patternAnyOne, 6}}, nil},
+		{"Subtree wildcard", "1.3.**", Pattern{arcs: []int64{1, 3, patternAnySubtree}}, nil},
+		{"Consecutive subtree wildcards are merged", "1.**.**.3", Pattern{arcs: []int64{1, patternAnySubtree, 3}}, nil},
+		{"Invalid - empty", "", Pattern{}, errors.New("cannot be empty")},
+		{"Invalid - consecutive periods", "1..3", Pattern{}, errors.New("cannot have consecutive periods")},
+		{"Invalid - ends with a period", "1.3.", Pattern{}, errors.New("cannot end with a period")},
+		{"Invalid - bad sub-identifier", "1.x.3", Pattern{}, errors.New(`invalid sub-identifier at position 2: "x"`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePattern(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePattern() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("ParsePattern() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Errorf("ParsePattern() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		oid     ObjectIdentifier
+		want    bool
+	}{
+		{"Exact match", "1.3.6", ObjectIdentifier{1, 3, 6}, true},
+		{"Exact mismatch", "1.3.6", ObjectIdentifier{1, 3, 7}, false},
+		{"Exact pattern is not a prefix match", "1.3.6", ObjectIdentifier{1, 3, 6, 1}, false},
+		{"Single-arc wildcard", "1.3.6.1.2.1.2.2.1.*.10", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 8, 10}, true},
+		{"Single-arc wildcard requires one arc", "1.3.*", ObjectIdentifier{1, 3}, false},
+		{"Single-arc wildcard matches only one arc", "1.3.*", ObjectIdentifier{1, 3, 6, 1}, false},
+		{"Subtree wildcard", "1.3.**", ObjectIdentifier{1, 3, 6, 1}, true},
+		{"Subtree wildcard matches nothing", "1.3.**", ObjectIdentifier{1, 3}, true},
+		{"Subtree wildcard in the middle", "1.**.10", ObjectIdentifier{1, 3, 6, 10}, true},
+		{"Subtree wildcard in the middle mismatch", "1.**.10", ObjectIdentifier{1, 3, 6, 11}, false},
+		{"Mixed wildcards", "1.*.**.5", ObjectIdentifier{1, 3, 5}, true},
+		{"Subtree wildcard needs backtracking", "1.**.2.3", ObjectIdentifier{1, 2, 9, 2, 3}, true},
+		{"Trailing subtree wildcards", "1.**.**", ObjectIdentifier{1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePattern(tt.pattern)
+			if err != nil {
+				t.Fatalf("ParsePattern() unexpected error = %v", err)
+			}
+
+			if got := p.Match(tt.oid); got != tt.want {
+				t.Errorf("Pattern.Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPattern_MatchManyWildcards(t *testing.T) {
+	oid := make(ObjectIdentifier, 128)
+	for i := range oid {
+		oid[i] = 1
+	}
+
+	// With backtracking per wildcard this would take exponential time.
+	p, err := ParsePattern(strings.Repeat("**.1.", 40) + "2")
+	if err != nil {
+		t.Fatalf("ParsePattern() unexpected error = %v", err)
+	}
+
+	if p.Match(oid) {
+		t.Errorf("Pattern.Match() = true, want false")
+	}
+
+	oid[len(oid)-1] = 2
+	if !p.Match(oid) {
+		t.Errorf("Pattern.Match() = false, want true")
+	}
+}
+
+func TestPattern_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern Pattern
+		want    string
+	}{
+		{"Plain pattern", Pattern{arcs: []int64{1, 2, 3}}, "1.2.3"},
+		{"Pattern with wildcards", Pattern{arcs: []int64{1, patternAnyOne, 3, patternAnySubtree}}, "1.*.3.**"},
+		{"Empty pattern", Pattern{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pattern.String(); got != tt.want {
+				t.Errorf("Pattern.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
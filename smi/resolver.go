@@ -0,0 +1,141 @@
+package smi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Resolver resolves symbolic object names to object identifiers.
+// Names are either plain descriptors such as "sysDescr" or qualified with their module, as in "IF-MIB::ifInOctets".
+type Resolver interface {
+	// ResolveName returns the object identifier of the named object.
+	ResolveName(name string) (ObjectIdentifier, error)
+}
+
+// ResolverFunc is an adapter to allow the use of ordinary functions as resolvers.
+type ResolverFunc func(name string) (ObjectIdentifier, error)
+
+// ResolveName calls f(name).
+func (f ResolverFunc) ResolveName(name string) (ObjectIdentifier, error) {
+	return f(name)
+}
+
+// Namer looks up symbolic names of object identifiers. It is the reverse of a Resolver.
+type Namer interface {
+	// LookupName returns the name of the object whose object identifier is the longest prefix of oid,
+	// together with the number of sub-identifiers of that prefix.
+	// Returns false if no named object is a prefix of oid.
+	LookupName(oid ObjectIdentifier) (string, int, bool)
+}
+
+// NameTable maps symbolic object names to object identifiers and back.
+// It implements both Resolver and Namer for callers that obtain the names themselves, e.g. from MIB modules.
+// The zero value is an empty table ready to use. A NameTable is not safe for concurrent modification.
+type NameTable struct {
+	oids  map[string]ObjectIdentifier
+	names Trie[string]
+}
+
+// Add registers an object with the given module, descriptor and object identifier.
+// The object resolves by its descriptor and, if the module is not empty, by its qualified name "MODULE::descriptor",
+// which is then also the name it is formatted with. If several objects share a descriptor,
+// the unqualified descriptor resolves to the one added last.
+func (t *NameTable) Add(module, descriptor string, oid ObjectIdentifier) {
+	if t.oids == nil {
+		t.oids = make(map[string]ObjectIdentifier)
+	}
+
+	oid = oid.clone()
+	name := descriptor
+	if module != "" {
+		name = module + "::" + descriptor
+		t.oids[name] = oid
+	}
+
+	t.oids[descriptor] = oid
+	t.names.Insert(oid, name)
+}
+
+// ResolveName returns the object identifier of the named object. Implements Resolver.
+func (t *NameTable) ResolveName(name string) (ObjectIdentifier, error) {
+	oid, ok := t.oids[name]
+	if !ok {
+		return nil, errors.New("unknown object name")
+	}
+
+	return oid.clone(), nil
+}
+
+// LookupName returns the name of the object whose object identifier is the longest prefix of oid. Implements Namer.
+func (t *NameTable) LookupName(oid ObjectIdentifier) (string, int, bool) {
+	prefix, name, ok := t.names.LongestPrefix(oid)
+	return name, len(prefix), ok
+}
+
+// FormatObjectIdentifierWithNamer returns the string representation of an object identifier
+// starting with the symbolic name of its longest named prefix, e.g. "IF-MIB::ifInOctets.3".
+// It is the reverse of ParseObjectIdentifierWithResolver.
+// Falls back to the numeric form if the namer is nil or knows no prefix of the object identifier.
+func FormatObjectIdentifierWithNamer(oid ObjectIdentifier, n Namer) string {
+	if n == nil {
+		return oid.String()
+	}
+
+	name, l, ok := n.LookupName(oid)
+	if !ok || l == 0 || l > len(oid) {
+		return oid.String()
+	}
+
+	if l == len(oid) {
+		return name
+	}
+
+	return name + "." + oid[l:].String()
+}
+
+// ParseObjectIdentifierWithResolver parses a string representation of an object identifier
+// which may start with a symbolic name, e.g. "IF-MIB::ifInOctets.3" or "sysDescr.0".
+// The name is resolved using the resolver and any numeric sub-identifiers following it are appended.
+// Purely numeric input is parsed as by ParseObjectIdentifier; a nil resolver only accepts numeric input.
+func ParseObjectIdentifierWithResolver(s string, r Resolver) (ObjectIdentifier, error) {
+	if s == "" || s[0] == '.' || (s[0] >= '0' && s[0] <= '9') {
+		return ParseObjectIdentifier(s)
+	}
+
+	name, suffix, hasSuffix := strings.Cut(s, ".")
+	// Module names never contain periods, so a period before "::" is a syntax error rather than a suffix.
+	if hasSuffix && strings.Contains(suffix, "::") {
+		return nil, fmt.Errorf("invalid symbolic name: %q", s)
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("cannot resolve %q: no resolver configured", name)
+	}
+
+	base, err := r.ResolveName(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %q: %w", name, err)
+	}
+
+	if !hasSuffix {
+		return base.clone(), base.Validate()
+	}
+
+	if suffix == "" {
+		return nil, errors.New("cannot end with a period")
+	}
+
+	// parseSubIdentifiers accepts a leading period, which here would follow the one separating the name.
+	if strings.HasPrefix(suffix, ".") {
+		return nil, errors.New("cannot have consecutive periods")
+	}
+
+	subids, err := parseSubIdentifiers(suffix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid suffix of %q: %w", name, err)
+	}
+
+	oid := base.Append(subids...)
+	return oid, oid.Validate()
+}
@@ -0,0 +1,134 @@
+package smi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var errTestUnknownName = errors.New("unknown name")
+
+var testResolver = ResolverFunc(func(name string) (ObjectIdentifier, error) {
+	switch name {
+	case "sysDescr", "SNMPv2-MIB::sysDescr":
+		return SysDescr, nil
+	case "IF-MIB::ifInOctets":
+		return ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10}, nil
+	default:
+		return nil, errTestUnknownName
+	}
+})
+
+func TestParseObjectIdentifierWithResolver(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		resolver Resolver
+		want     ObjectIdentifier
+		wantErr  bool
+	}{
+		{"Numeric OID", "1.3.6.1", testResolver, ObjectIdentifier{1, 3, 6, 1}, false},
+		{"Numeric OID without resolver", ".1.3.6.1", nil, ObjectIdentifier{1, 3, 6, 1}, false},
+		{"Descriptor with instance", "sysDescr.0", testResolver, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, false},
+		{"Descriptor without instance", "sysDescr", testResolver, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1}, false},
+		{"Qualified name with instance", "IF-MIB::ifInOctets.3", testResolver, ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10, 3}, false},
+		{"Qualified name with multi-part suffix", "SNMPv2-MIB::sysDescr.0.1", testResolver, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0, 1}, false},
+		{"Invalid - unknown name", "ifHCInOctets.3", testResolver, nil, true},
+		{"Invalid - no resolver", "sysDescr.0", nil, nil, true},
+		{"Invalid - trailing period", "sysDescr.", testResolver, nil, true},
+		{"Invalid - bad suffix", "sysDescr.x", testResolver, nil, true},
+		{"Invalid - consecutive periods", "sysDescr..0", testResolver, nil, true},
+		{"Invalid - period in module name", "IF.MIB::ifInOctets", testResolver, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseObjectIdentifierWithResolver(tt.input, tt.resolver)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseObjectIdentifierWithResolver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseObjectIdentifierWithResolver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseObjectIdentifierWithResolver_WrapsError(t *testing.T) {
+	_, err := ParseObjectIdentifierWithResolver("ifHCInOctets.3", testResolver)
+	if !errors.Is(err, errTestUnknownName) {
+		t.Errorf("ParseObjectIdentifierWithResolver() error = %v, want wrapped %v", err, errTestUnknownName)
+	}
+}
+
+func TestParseObjectIdentifierWithResolver_DoesNotAlias(t *testing.T) {
+	got, err := ParseObjectIdentifierWithResolver("sysDescr", testResolver)
+	if err != nil {
+		t.Fatalf("ParseObjectIdentifierWithResolver() unexpected error = %v", err)
+	}
+
+	got[0] = 2
+	if SysDescr[0] != 1 {
+		t.Errorf("ParseObjectIdentifierWithResolver() returned OID sharing memory with the resolver")
+	}
+}
+
+func newTestNameTable() *NameTable {
+	var t NameTable
+	t.Add("SNMPv2-MIB", "system", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1})
+	t.Add("SNMPv2-MIB", "sysDescr", SysDescr)
+	t.Add("IF-MIB", "ifInOctets", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10})
+	t.Add("", "enterprises", ObjectIdentifier{1, 3, 6, 1, 4, 1})
+
+	return &t
+}
+
+func TestFormatObjectIdentifierWithNamer(t *testing.T) {
+	table := newTestNameTable()
+
+	tests := []struct {
+		name  string
+		oid   ObjectIdentifier
+		namer Namer
+		want  string
+	}{
+		{"Exact name", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1}, table, "SNMPv2-MIB::sysDescr"},
+		{"Name with instance", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10, 3}, table, "IF-MIB::ifInOctets.3"},
+		{"Longest prefix wins", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, table, "SNMPv2-MIB::sysDescr.0"},
+		{"Shorter named prefix", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}, table, "SNMPv2-MIB::system.5.0"},
+		{"Name without module", ObjectIdentifier{1, 3, 6, 1, 4, 1, 9}, table, "enterprises.9"},
+		{"No named prefix", ObjectIdentifier{1, 3, 6, 1, 6, 3}, table, "1.3.6.1.6.3"},
+		{"No namer", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, nil, "1.3.6.1.2.1.1.1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatObjectIdentifierWithNamer(tt.oid, tt.namer); got != tt.want {
+				t.Errorf("FormatObjectIdentifierWithNamer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameTable_RoundTrip(t *testing.T) {
+	table := newTestNameTable()
+
+	for _, s := range []string{"SNMPv2-MIB::sysDescr.0", "IF-MIB::ifInOctets.3", "enterprises.9.1"} {
+		oid, err := ParseObjectIdentifierWithResolver(s, table)
+		if err != nil {
+			t.Fatalf("ParseObjectIdentifierWithResolver(%q) unexpected error = %v", s, err)
+		}
+
+		if got := FormatObjectIdentifierWithNamer(oid, table); got != s {
+			t.Errorf("FormatObjectIdentifierWithNamer(%v) = %v, want %v", oid, got, s)
+		}
+	}
+
+	if got, err := table.ResolveName("ifInOctets"); err != nil || !got.Equals(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10}) {
+		t.Errorf("NameTable.ResolveName() of unqualified descriptor = %v, %v", got, err)
+	}
+
+	if _, err := table.ResolveName("IF-MIB::ifOutOctets"); err == nil {
+		t.Errorf("NameTable.ResolveName() of unknown name did not fail")
+	}
+}
@@ -0,0 +1,115 @@
+package smi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// GenericTrap is the generic-trap field of an SNMPv1 Trap-PDU as defined in RFC 1157 section 4.1.6.
+type GenericTrap int
+
+const (
+	GenericTrapColdStart GenericTrap = iota
+	GenericTrapWarmStart
+	GenericTrapLinkDown
+	GenericTrapLinkUp
+	GenericTrapAuthenticationFailure
+	GenericTrapEgpNeighborLoss
+	GenericTrapEnterpriseSpecific
+)
+
+// genericTrapNames holds the labels of the generic-trap values as used in RFC 1157.
+var genericTrapNames = [...]string{
+	GenericTrapColdStart:             "coldStart",
+	GenericTrapWarmStart:             "warmStart",
+	GenericTrapLinkDown:              "linkDown",
+	GenericTrapLinkUp:                "linkUp",
+	GenericTrapAuthenticationFailure: "authenticationFailure",
+	GenericTrapEgpNeighborLoss:       "egpNeighborLoss",
+	GenericTrapEnterpriseSpecific:    "enterpriseSpecific",
+}
+
+// IsValid returns true if the generic-trap is one of the values defined in RFC 1157.
+func (g GenericTrap) IsValid() bool {
+	return g >= 0 && int(g) < len(genericTrapNames)
+}
+
+// String returns the label of the generic-trap, e.g. "linkDown".
+// Unknown values are formatted as "genericTrap(7)".
+func (g GenericTrap) String() string {
+	if !g.IsValid() {
+		return "genericTrap(" + strconv.Itoa(int(g)) + ")"
+	}
+
+	return genericTrapNames[g]
+}
+
+// V1Trap identifies the notification carried by an SNMPv1 Trap-PDU.
+// It is translated to and from the snmpTrapOID.0 value of SNMPv2 notifications as defined in RFC 3584 section 3.
+type V1Trap struct {
+	Enterprise ObjectIdentifier
+	Generic    GenericTrap
+	Specific   uint32
+}
+
+// TrapOID returns the snmpTrapOID.0 value of the SNMPv2 notification corresponding to the trap,
+// as defined in RFC 3584 section 3.1:
+//
+//	generic-trap 0-5:  snmpTraps.(generic-trap + 1), e.g. 1.3.6.1.6.3.1.1.5.3 for linkDown
+//	enterpriseSpecific: enterprise.0.specific-trap
+//
+// For generic traps, the enterprise is conveyed in a snmpTrapEnterprise.0 variable binding instead.
+// The returned object identifier does not share memory with the enterprise.
+func (t V1Trap) TrapOID() (ObjectIdentifier, error) {
+	switch {
+	case !t.Generic.IsValid():
+		return nil, fmt.Errorf("invalid generic-trap: %d", t.Generic)
+	case t.Generic != GenericTrapEnterpriseSpecific:
+		return SnmpTraps.Append(uint32(t.Generic) + 1), nil
+	}
+
+	if err := t.Enterprise.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid enterprise: %w", err)
+	}
+
+	return t.Enterprise.Append(0, t.Specific), nil
+}
+
+// V1TrapFromTrapOID returns the SNMPv1 trap corresponding to an SNMPv2 notification, as defined in RFC 3584 section 3.2.
+// The enterprise is the value of the snmpTrapEnterprise.0 variable binding, or nil if the notification has none;
+// it is only used for the generic traps snmpTraps.1 through snmpTraps.6, which default to the snmpTraps enterprise.
+// For all other notifications the enterprise is derived from the trap OID by removing its last sub-identifier,
+// and also the one before if that is zero. The returned object identifiers do not share memory with the arguments.
+func V1TrapFromTrapOID(trapOID, enterprise ObjectIdentifier) (V1Trap, error) {
+	if err := trapOID.Validate(); err != nil {
+		return V1Trap{}, fmt.Errorf("invalid trap OID: %w", err)
+	}
+
+	last := trapOID[len(trapOID)-1]
+	if len(trapOID) == len(SnmpTraps)+1 && trapOID.HasPrefix(SnmpTraps) && last >= 1 && last <= 6 {
+		if enterprise == nil {
+			enterprise = SnmpTraps
+		}
+
+		return V1Trap{
+			Enterprise: enterprise.clone(),
+			Generic:    GenericTrap(last - 1),
+		}, nil
+	}
+
+	base := trapOID[:len(trapOID)-1]
+	if base[len(base)-1] == 0 {
+		base = base[:len(base)-1]
+	}
+
+	if len(base) == 0 {
+		return V1Trap{}, errors.New("trap OID has no enterprise")
+	}
+
+	return V1Trap{
+		Enterprise: base.clone(),
+		Generic:    GenericTrapEnterpriseSpecific,
+		Specific:   last,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package smi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestV1Trap_TrapOID(t *testing.T) {
+	enterprise := ObjectIdentifier{1, 3, 6, 1, 4, 1, 9}
+
+	tests := []struct {
+		name    string
+		trap    V1Trap
+		want    ObjectIdentifier
+		wantErr bool
+	}{
+		{"coldStart", V1Trap{Enterprise: enterprise, Generic: GenericTrapColdStart}, ColdStart, false},
+		{"linkDown", V1Trap{Enterprise: enterprise, Generic: GenericTrapLinkDown}, LinkDown, false},
+		{"egpNeighborLoss", V1Trap{Generic: GenericTrapEgpNeighborLoss}, ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 6}, false},
+		{"Enterprise-specific", V1Trap{Enterprise: enterprise, Generic: GenericTrapEnterpriseSpecific, Specific: 17}, ObjectIdentifier{1, 3, 6, 1, 4, 1, 9, 0, 17}, false},
+		{"Invalid - generic-trap out of range", V1Trap{Enterprise: enterprise, Generic: 7}, nil, true},
+		{"Invalid - enterprise-specific without enterprise", V1Trap{Generic: GenericTrapEnterpriseSpecific, Specific: 1}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.trap.TrapOID()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("V1Trap.TrapOID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("V1Trap.TrapOID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV1TrapFromTrapOID(t *testing.T) {
+	enterprise := ObjectIdentifier{1, 3, 6, 1, 4, 1, 9}
+
+	tests := []struct {
+		name       string
+		trapOID    ObjectIdentifier
+		enterprise ObjectIdentifier
+		want       V1Trap
+		wantErr    bool
+	}{
+		{"Generic trap with enterprise", LinkUp, enterprise, V1Trap{Enterprise: enterprise, Generic: GenericTrapLinkUp}, false},
+		{"Generic trap without enterprise", WarmStart, nil, V1Trap{Enterprise: SnmpTraps, Generic: GenericTrapWarmStart}, false},
+		{"Translated enterprise-specific trap", ObjectIdentifier{1, 3, 6, 1, 4, 1, 9, 0, 17}, nil, V1Trap{Enterprise: enterprise, Generic: GenericTrapEnterpriseSpecific, Specific: 17}, false},
+		{"SNMPv2 notification", ObjectIdentifier{1, 3, 6, 1, 2, 1, 88, 2, 1}, enterprise, V1Trap{Enterprise: ObjectIdentifier{1, 3, 6, 1, 2, 1, 88, 2}, Generic: GenericTrapEnterpriseSpecific, Specific: 1}, false},
+		{"Undefined snmpTraps notification", ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 7}, nil, V1Trap{Enterprise: SnmpTraps, Generic: GenericTrapEnterpriseSpecific, Specific: 7}, false},
+		{"Invalid - too short", ObjectIdentifier{1}, nil, V1Trap{}, true},
+		{"Invalid - no enterprise left", ObjectIdentifier{0, 0}, nil, V1Trap{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := V1TrapFromTrapOID(tt.trapOID, tt.enterprise)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("V1TrapFromTrapOID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("V1TrapFromTrapOID() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV1Trap_RoundTrip(t *testing.T) {
+	for g := GenericTrapColdStart; g <= GenericTrapEnterpriseSpecific; g++ {
+		trap := V1Trap{Enterprise: ObjectIdentifier{1, 3, 6, 1, 4, 1, 9}, Generic: g}
+		if g == GenericTrapEnterpriseSpecific {
+			trap.Specific = 42
+		}
+
+		oid, err := trap.TrapOID()
+		if err != nil {
+			t.Fatalf("V1Trap.TrapOID() unexpected error = %v", err)
+		}
+
+		got, err := V1TrapFromTrapOID(oid, trap.Enterprise)
+		if err != nil || !reflect.DeepEqual(got, trap) {
+			t.Errorf("V1TrapFromTrapOID(%v) = %+v, %v, want %+v", oid, got, err, trap)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package smi
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Trie is a prefix tree mapping object identifiers to values.
+// Besides exact lookups it supports longest-prefix matches and ordered traversal in SNMP lexicographic order,
+// as needed for GETNEXT processing and prefix-based dispatching.
+// The zero value is an empty trie ready to use. A Trie is not safe for concurrent modification.
+type Trie[V any] struct {
+	root trieNode[V]
+	len  int
+}
+
+// trieNode is a node of a Trie. Children are kept sorted by their sub-identifier.
+type trieNode[V any] struct {
+	sub      uint32
+	children []*trieNode[V]
+	value    V
+	set      bool
+}
+
+// child returns the position of the child with the given sub-identifier and whether it exists.
+// If it does not exist, the position is where it would have to be inserted.
+func (n *trieNode[V]) child(sub uint32) (int, bool) {
+	return slices.BinarySearchFunc(n.children, sub, func(c *trieNode[V], s uint32) int {
+		return cmp.Compare(c.sub, s)
+	})
+}
+
+// first returns the first entry in the subtree rooted at n in lexicographic order.
+// The prefix is the object identifier of the parent of n and must not share memory with caller-owned OIDs.
+func (n *trieNode[V]) first(prefix ObjectIdentifier) (ObjectIdentifier, *trieNode[V]) {
+	prefix = append(prefix, n.sub)
+	if n.set {
+		return prefix, n
+	}
+
+	for _, c := range n.children {
+		if oid, m := c.first(prefix); m != nil {
+			return oid, m
+		}
+	}
+
+	return nil, nil
+}
+
+// Len returns the number of entries in the trie.
+func (t *Trie[V]) Len() int {
+	return t.len
+}
+
+// Insert sets the value for the given object identifier, replacing any existing value.
+func (t *Trie[V]) Insert(oid ObjectIdentifier, v V) {
+	n := &t.root
+	for _, sub := range oid {
+		i, ok := n.child(sub)
+		if !ok {
+			n.children = slices.Insert(n.children, i, &trieNode[V]{sub: sub})
+		}
+
+		n = n.children[i]
+	}
+
+	if !n.set {
+		t.len++
+	}
+
+	n.value, n.set = v, true
+}
+
+// Get returns the value for the given object identifier.
+// Returns false if there is no entry for the object identifier.
+func (t *Trie[V]) Get(oid ObjectIdentifier) (V, bool) {
+	n := &t.root
+	for _, sub := range oid {
+		i, ok := n.child(sub)
+		if !ok {
+			var zero V
+			return zero, false
+		}
+
+		n = n.children[i]
+	}
+
+	return n.value, n.set
+}
+
+// Delete removes the entry for the given object identifier.
+// Returns false if there was no entry for the object identifier.
+func (t *Trie[V]) Delete(oid ObjectIdentifier) bool {
+	path := make([]*trieNode[V], 0, len(oid)+1)
+	path = append(path, &t.root)
+
+	n := &t.root
+	for _, sub := range oid {
+		i, ok := n.child(sub)
+		if !ok {
+			return false
+		}
+
+		n = n.children[i]
+		path = append(path, n)
+	}
+
+	if !n.set {
+		return false
+	}
+
+	var zero V
+	n.value, n.set = zero, false
+	t.len--
+
+	// Prune nodes which no longer lead to any entry.
+	for d := len(path) - 1; d > 0; d-- {
+		if path[d].set || len(path[d].children) > 0 {
+			break
+		}
+
+		parent := path[d-1]
+		i, _ := parent.child(path[d].sub)
+		parent.children = slices.Delete(parent.children, i, i+1)
+	}
+
+	return true
+}
+
+// LongestPrefix returns the entry whose object identifier is the longest prefix of the given object identifier,
+// including the object identifier itself. Returns false if no entry is a prefix of the object identifier.
+func (t *Trie[V]) LongestPrefix(oid ObjectIdentifier) (ObjectIdentifier, V, bool) {
+	var (
+		n     = &t.root
+		match *trieNode[V]
+		depth int
+	)
+	if n.set {
+		match = n
+	}
+
+	for i, sub := range oid {
+		j, ok := n.child(sub)
+		if !ok {
+			break
+		}
+
+		n = n.children[j]
+		if n.set {
+			match, depth = n, i+1
+		}
+	}
+
+	if match == nil {
+		var zero V
+		return nil, zero, false
+	}
+
+	return oid[:depth].clone(), match.value, true
+}
+
+// Next returns the first entry whose object identifier comes strictly after the given object identifier
+// in SNMP lexicographic order, i.e. the entry a GETNEXT request for oid would return.
+// Returns false if there is no such entry.
+func (t *Trie[V]) Next(oid ObjectIdentifier) (ObjectIdentifier, V, bool) {
+	path := make([]*trieNode[V], 0, len(oid)+1)
+	path = append(path, &t.root)
+
+	n := &t.root
+	for _, sub := range oid {
+		i, ok := n.child(sub)
+		if !ok {
+			break
+		}
+
+		n = n.children[i]
+		path = append(path, n)
+	}
+
+	depth := len(path) - 1
+	if depth == len(oid) {
+		// The object identifier itself is in the trie, so its first descendant follows it.
+		prefix := oid.clone()
+		for _, c := range n.children {
+			if next, m := c.first(prefix); m != nil {
+				return next, m.value, true
+			}
+		}
+
+		depth--
+	}
+
+	// Otherwise the next entry is in a later sibling subtree of one of the nodes along the path.
+	for d := depth; d >= 0; d-- {
+		p := path[d]
+		i, ok := p.child(oid[d])
+		if ok {
+			i++
+		}
+
+		prefix := oid[:d].clone()
+		for _, c := range p.children[i:] {
+			if next, m := c.first(prefix); m != nil {
+				return next, m.value, true
+			}
+		}
+	}
+
+	var zero V
+	return nil, zero, false
+}
+
+// All returns an iterator over all entries of the trie in SNMP lexicographic order.
+// The trie must not be modified during iteration.
+func (t *Trie[V]) All() iter.Seq2[ObjectIdentifier, V] {
+	return func(yield func(ObjectIdentifier, V) bool) {
+		if t.root.set && !yield(ObjectIdentifier{}, t.root.value) {
+			return
+		}
+
+		var walk func(n *trieNode[V], prefix ObjectIdentifier) bool
+		walk = func(n *trieNode[V], prefix ObjectIdentifier) bool {
+			prefix = append(prefix, n.sub)
+			if n.set && !yield(prefix.clone(), n.value) {
+				return false
+			}
+
+			for _, c := range n.children {
+				if !walk(c, prefix) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		for _, c := range t.root.children {
+			if !walk(c, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package smi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestTrie() *Trie[string] {
+	var t Trie[string]
+	t.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "system")
+	t.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, "sysDescr.0")
+	t.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, "sysUpTime.0")
+	t.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}, "ifDescr.10")
+	t.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 2}, "ifDescr.2")
+
+	return &t
+}
+
+func TestTrie_Get(t *testing.T) {
+	trie := newTestTrie()
+
+	tests := []struct {
+		name   string
+		oid    ObjectIdentifier
+		want   string
+		wantOK bool
+	}{
+		{"Leaf entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, "sysUpTime.0", true},
+		{"Inner entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "system", true},
+		{"Inner node without entry", ObjectIdentifier{1, 3, 6, 1, 2, 1}, "", false},
+		{"Missing entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 2, 0}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := trie.Get(tt.oid)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Trie.Get() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTrie_InsertDelete(t *testing.T) {
+	trie := newTestTrie()
+	if got := trie.Len(); got != 5 {
+		t.Fatalf("Trie.Len() = %v, want 5", got)
+	}
+
+	trie.Insert(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "replaced")
+	if got := trie.Len(); got != 5 {
+		t.Errorf("Trie.Len() after replace = %v, want 5", got)
+	}
+
+	if !trie.Delete(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}) {
+		t.Errorf("Trie.Delete() = false, want true")
+	}
+
+	if trie.Delete(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}) {
+		t.Errorf("Trie.Delete() of deleted entry = true, want false")
+	}
+
+	if trie.Delete(ObjectIdentifier{1, 3, 6, 1}) {
+		t.Errorf("Trie.Delete() of inner node = true, want false")
+	}
+
+	if got := trie.Len(); got != 4 {
+		t.Errorf("Trie.Len() after delete = %v, want 4", got)
+	}
+
+	if oid, _, ok := trie.Next(ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 2}); ok {
+		t.Errorf("Trie.Next() after delete = %v, want no entry", oid)
+	}
+
+	if got, _ := trie.Get(ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}); got != "replaced" {
+		t.Errorf("Trie.Get() = %v, want replaced", got)
+	}
+}
+
+func TestTrie_LongestPrefix(t *testing.T) {
+	trie := newTestTrie()
+
+	tests := []struct {
+		name       string
+		oid        ObjectIdentifier
+		wantPrefix ObjectIdentifier
+		want       string
+		wantOK     bool
+	}{
+		{"Exact entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, "sysDescr.0", true},
+		{"Below a leaf entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0, 5}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, "sysDescr.0", true},
+		{"Below an inner entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 2, 0}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "system", true},
+		{"No prefix entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 1, 0}, nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, got, ok := trie.LongestPrefix(tt.oid)
+			if !reflect.DeepEqual(prefix, tt.wantPrefix) || got != tt.want || ok != tt.wantOK {
+				t.Errorf("Trie.LongestPrefix() = %v, %v, %v, want %v, %v, %v", prefix, got, ok, tt.wantPrefix, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTrie_Next(t *testing.T) {
+	trie := newTestTrie()
+
+	tests := []struct {
+		name    string
+		oid     ObjectIdentifier
+		wantOID ObjectIdentifier
+		want    string
+		wantOK  bool
+	}{
+		{"Before all entries", ObjectIdentifier{1, 3}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "system", true},
+		{"Empty OID", ObjectIdentifier{}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, "system", true},
+		{"Descendant of entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, "sysDescr.0", true},
+		{"Sibling of entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 1, 0}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, "sysUpTime.0", true},
+		{"Between entries", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 2}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, "sysUpTime.0", true},
+		{"Jump to other subtree", ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 2}, "ifDescr.2", true},
+		{"Numeric order of instances", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 2}, ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}, "ifDescr.10", true},
+		{"Last entry", ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 10}, nil, "", false},
+		{"After all entries", ObjectIdentifier{2}, nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oid, got, ok := trie.Next(tt.oid)
+			if !reflect.DeepEqual(oid, tt.wantOID) || got != tt.want || ok != tt.wantOK {
+				t.Errorf("Trie.Next() = %v, %v, %v, want %v, %v, %v", oid, got, ok, tt.wantOID, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTrie_All(t *testing.T) {
+	trie := newTestTrie()
+
+	var got []string
+	for oid, v := range trie.All() {
+		got = append(got, oid.String()+"="+v)
+	}
+
+	want := []string{
+		"1.3.6.1.2.1.1=system",
+		"1.3.6.1.2.1.1.1.0=sysDescr.0",
+		"1.3.6.1.2.1.1.3.0=sysUpTime.0",
+		"1.3.6.1.2.1.2.2.1.2.2=ifDescr.2",
+		"1.3.6.1.2.1.2.2.1.2.10=ifDescr.10",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Trie.All() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package smi
+
+// Well-known object identifiers of the SNMPv2-MIB (RFC 3418) and IF-MIB (RFC 2863).
+// Scalar objects are given without their instance sub-identifier; use Append(0) to address the instance.
+// The values are shared and must not be modified.
+var (
+	// System group
+	SysDescr    = MustParseObjectIdentifier("1.3.6.1.2.1.1.1")
+	SysObjectID = MustParseObjectIdentifier("1.3.6.1.2.1.1.2")
+	SysUpTime   = MustParseObjectIdentifier("1.3.6.1.2.1.1.3")
+	SysContact  = MustParseObjectIdentifier("1.3.6.1.2.1.1.4")
+	SysName     = MustParseObjectIdentifier("1.3.6.1.2.1.1.5")
+	SysLocation = MustParseObjectIdentifier("1.3.6.1.2.1.1.6")
+	SysServices = MustParseObjectIdentifier("1.3.6.1.2.1.1.7")
+
+	// Interfaces group
+	IfNumber = MustParseObjectIdentifier("1.3.6.1.2.1.2.1")
+	IfTable  = MustParseObjectIdentifier("1.3.6.1.2.1.2.2")
+	IfEntry  = MustParseObjectIdentifier("1.3.6.1.2.1.2.2.1")
+	IfXTable = MustParseObjectIdentifier("1.3.6.1.2.1.31.1.1")
+	IfXEntry = MustParseObjectIdentifier("1.3.6.1.2.1.31.1.1.1")
+
+	// Notification objects
+	SnmpTrapOID        = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.4.1")
+	SnmpTrapEnterprise = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.4.3")
+
+	// Generic notifications
+	SnmpTraps             = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5")
+	ColdStart             = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5.1")
+	WarmStart             = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5.2")
+	LinkDown              = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5.3")
+	LinkUp                = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5.4")
+	AuthenticationFailure = MustParseObjectIdentifier("1.3.6.1.6.3.1.1.5.5")
+)